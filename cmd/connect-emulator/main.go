@@ -0,0 +1,68 @@
+// Command connect-emulator serves an emulated 1Password Connect API on localhost, to run Terraform
+// configurations using the provider without a 1Password account or Connect server.
+//
+//	go run github.com/1Password/terraform-provider-onepassword/v2/cmd/connect-emulator -data seed.json
+//
+// The data file seeds the emulator with vaults and items in the JSON format of the Connect API:
+//
+//	{"vaults": [{"id": "...", "name": "..."}], "items": [{"title": "...", "vault": {"id": "..."}, "category": "LOGIN", "fields": [...]}]}
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"log"
+	"net/http"
+	"os"
+
+	"github.com/1Password/connect-sdk-go/onepassword"
+
+	"github.com/1Password/terraform-provider-onepassword/v2/connectemulator"
+)
+
+type seedData struct {
+	Vaults []onepassword.Vault `json:"vaults"`
+	Items  []onepassword.Item  `json:"items"`
+}
+
+func main() {
+	var addr, dataFile string
+	var config connectemulator.Config
+
+	flag.StringVar(&addr, "addr", "127.0.0.1:8080", "address to listen on")
+	flag.StringVar(&dataFile, "data", "", "JSON file with the vaults and items to start with")
+	flag.StringVar(&config.Token, "token", "", "token requests must be sent with, every token is accepted when empty")
+	flag.DurationVar(&config.ConsistencyDelay, "consistency-delay", 0, "how long it takes until reads return the result of a write")
+	flag.Parse()
+
+	e := connectemulator.New(config)
+	if dataFile != "" {
+		if err := seed(e, dataFile); err != nil {
+			log.Fatalf("failed to load data file: %s", err)
+		}
+	}
+
+	log.Printf("1Password Connect emulator listening on http://%s", addr)
+	log.Fatal(http.ListenAndServe(addr, e))
+}
+
+func seed(e *connectemulator.Emulator, dataFile string) error {
+	content, err := os.ReadFile(dataFile)
+	if err != nil {
+		return err
+	}
+	var data seedData
+	if err := json.Unmarshal(content, &data); err != nil {
+		return err
+	}
+
+	for _, vault := range data.Vaults {
+		e.AddVault(vault)
+	}
+	for _, item := range data.Items {
+		if _, err := e.AddItem(item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
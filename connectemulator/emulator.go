@@ -0,0 +1,735 @@
+// Package connectemulator emulates the 1Password Connect API in memory, so the provider and the modules
+// using it can be tested without a 1Password account or Connect server.
+package connectemulator
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/1Password/connect-sdk-go/onepassword"
+
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/model"
+)
+
+// connectVersion is returned in the 1Password-Connect-Version header. The Connect SDK requires at least 1.3.0 to read files.
+const connectVersion = "1.5.7"
+
+var (
+	vaultsPath      = regexp.MustCompile(`^/v1/vaults$`)
+	vaultPath       = regexp.MustCompile(`^/v1/vaults/([^/]+)$`)
+	itemsPath       = regexp.MustCompile(`^/v1/vaults/([^/]+)/items$`)
+	itemPath        = regexp.MustCompile(`^/v1/vaults/([^/]+)/items/([^/]+)$`)
+	filesPath       = regexp.MustCompile(`^/v1/vaults/([^/]+)/items/([^/]+)/files$`)
+	filePath        = regexp.MustCompile(`^/v1/vaults/([^/]+)/items/([^/]+)/files/([^/]+)$`)
+	fileContentPath = regexp.MustCompile(`^/v1/vaults/([^/]+)/items/([^/]+)/files/([^/]+)/content$`)
+	titleFilter     = regexp.MustCompile(`^title eq "(.*)"$`)
+)
+
+// Config configures the emulator.
+type Config struct {
+	// Token is the bearer token requests must be sent with. Every token is accepted when empty.
+	Token string
+	// ConsistencyDelay is how long it takes until reads return the result of a write, like Connect does while it syncs with 1Password.
+	ConsistencyDelay time.Duration
+}
+
+// Fault makes matching requests fail instead of being handled.
+type Fault struct {
+	// Method is the HTTP method of the requests to fail. Requests with any method match when empty.
+	Method string
+	// Path matches the paths of the requests to fail. Requests to any path match when nil.
+	Path *regexp.Regexp
+	// StatusCode is the status code of the error response.
+	StatusCode int
+	// Message is the message of the error response. Defaults to the message Connect returns for the status code.
+	Message string
+	// Count is how many requests fail. When 0, requests fail until the faults are cleared.
+	Count int
+}
+
+// Emulator is a stateful emulation of the 1Password Connect API. It keeps vaults, items and files in memory,
+// bumps item versions on updates and supports title filters, delayed consistency and injected faults.
+// Connect has no API to manage vaults and files, so they are added with AddVault and AddFile.
+type Emulator struct {
+	mu     sync.Mutex
+	config Config
+	vaults map[string]*vaultRecord
+	faults []*Fault
+}
+
+type vaultRecord struct {
+	vault onepassword.Vault
+	items map[string]*itemRecord
+}
+
+// itemRecord holds the latest state of an item and the state reads return until the latest state is visible.
+type itemRecord struct {
+	item      *onepassword.Item
+	visible   *onepassword.Item
+	visibleAt time.Time
+	files     map[string][]byte
+}
+
+// read returns the item as currently seen by reads, nil when it doesn't exist (yet or anymore).
+func (r *itemRecord) read(now time.Time) *onepassword.Item {
+	if now.Before(r.visibleAt) {
+		return r.visible
+	}
+	return r.item
+}
+
+func (r *itemRecord) write(item *onepassword.Item, now time.Time, delay time.Duration) {
+	r.visible = r.read(now)
+	r.item = item
+	r.visibleAt = now.Add(delay)
+}
+
+func New(config Config) *Emulator {
+	return &Emulator{
+		config: config,
+		vaults: map[string]*vaultRecord{},
+	}
+}
+
+// AddVault adds a vault and returns it with its ID, which is generated when not set.
+func (e *Emulator) AddVault(vault onepassword.Vault) onepassword.Vault {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	now := time.Now().UTC()
+	if vault.ID == "" {
+		vault.ID = newID()
+	}
+	if vault.CreatedAt.IsZero() {
+		vault.CreatedAt = now
+	}
+	if vault.UpdatedAt.IsZero() {
+		vault.UpdatedAt = now
+	}
+	if vault.Type == "" {
+		vault.Type = onepassword.UserCreatedVault
+	}
+
+	e.vaults[vault.ID] = &vaultRecord{
+		vault: vault,
+		items: map[string]*itemRecord{},
+	}
+	return vault
+}
+
+// AddItem adds an item that is visible right away, and returns it. The ID of the item and its fields are generated when not set.
+func (e *Emulator) AddItem(item onepassword.Item) (*onepassword.Item, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	vault, ok := e.vaults[item.Vault.ID]
+	if !ok {
+		return nil, fmt.Errorf("vault %q not found", item.Vault.ID)
+	}
+
+	created, err := newItem(&item, item.ID)
+	if err != nil {
+		return nil, err
+	}
+	if item.Version > 0 {
+		created.Version = item.Version
+	}
+	created.Files = nil
+	vault.items[created.ID] = &itemRecord{
+		item:  created,
+		files: map[string][]byte{},
+	}
+	return cloneItem(created), nil
+}
+
+// AddItemFromModel adds a provider model item, see AddItem. It is only usable from within the provider,
+// as the model package is internal; other modules add items with AddItem.
+func (e *Emulator) AddItemFromModel(item *model.Item) (*onepassword.Item, error) {
+	connectItem, err := item.FromModelItemToConnect()
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := e.AddItem(*connectItem)
+	if err != nil {
+		return nil, err
+	}
+	for _, file := range item.Files {
+		content, err := file.Content()
+		if err != nil {
+			return nil, err
+		}
+		section := &onepassword.ItemSection{ID: file.SectionID, Label: file.SectionLabel}
+		if file.SectionID == "" {
+			section = nil
+		}
+		if created, err = e.AddFile(created.Vault.ID, created.ID, onepassword.File{ID: file.ID, Name: file.Name, Section: section}, content); err != nil {
+			return nil, err
+		}
+	}
+	return created, nil
+}
+
+// AddFile attaches a file to an item without bumping its version and returns the item.
+func (e *Emulator) AddFile(vaultID, itemID string, file onepassword.File, content []byte) (*onepassword.Item, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	record, err := e.latestItem(vaultID, itemID)
+	if err != nil {
+		return nil, err
+	}
+	if file.ID == "" {
+		file.ID = newID()
+	}
+	file.Size = len(content)
+	file.ContentPath = fmt.Sprintf("/v1/vaults/%s/items/%s/files/%s/content", vaultID, itemID, file.ID)
+
+	record.item.Files = append(record.item.Files, &file)
+	record.files[file.ID] = slices.Clone(content)
+	return cloneItem(record.item), nil
+}
+
+// RemoveFile removes a file from an item without bumping its version.
+func (e *Emulator) RemoveFile(vaultID, itemID, fileID string) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	record, err := e.latestItem(vaultID, itemID)
+	if err != nil {
+		return err
+	}
+	i := slices.IndexFunc(record.item.Files, func(f *onepassword.File) bool { return f.ID == fileID })
+	if i == -1 {
+		return fmt.Errorf("file %q not found", fileID)
+	}
+	record.item.Files = slices.Delete(record.item.Files, i, i+1)
+	delete(record.files, fileID)
+	return nil
+}
+
+// Item returns the latest state of an item, even when reads don't return it yet.
+func (e *Emulator) Item(vaultID, itemID string) (*onepassword.Item, bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	record, err := e.latestItem(vaultID, itemID)
+	if err != nil {
+		return nil, false
+	}
+	return cloneItem(record.item), true
+}
+
+// Items returns the latest state of all items in a vault.
+func (e *Emulator) Items(vaultID string) []*onepassword.Item {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	vault, ok := e.vaults[vaultID]
+	if !ok {
+		return nil
+	}
+	var items []*onepassword.Item
+	for _, record := range vault.items {
+		if record.item != nil {
+			items = append(items, cloneItem(record.item))
+		}
+	}
+	sortItems(items)
+	return items
+}
+
+// SetConsistencyDelay changes how long it takes until reads return the result of a write.
+func (e *Emulator) SetConsistencyDelay(delay time.Duration) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.config.ConsistencyDelay = delay
+}
+
+// InjectFault makes matching requests fail. Faults are checked in the order they are injected.
+func (e *Emulator) InjectFault(fault Fault) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.faults = append(e.faults, &fault)
+}
+
+// ClearFaults removes all injected faults.
+func (e *Emulator) ClearFaults() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.faults = nil
+}
+
+func (e *Emulator) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	w.Header().Set("1Password-Connect-Version", connectVersion)
+
+	if e.config.Token != "" && r.Header.Get("Authorization") != "Bearer "+e.config.Token {
+		writeError(w, http.StatusUnauthorized, "Invalid token signature")
+		return
+	}
+	if fault := e.matchFault(r); fault != nil {
+		writeError(w, fault.StatusCode, fault.Message)
+		return
+	}
+
+	now := time.Now().UTC()
+	path := r.URL.Path
+
+	switch {
+	case vaultsPath.MatchString(path) && r.Method == http.MethodGet:
+		e.listVaults(w, r, now)
+	case vaultPath.MatchString(path) && r.Method == http.MethodGet:
+		m := vaultPath.FindStringSubmatch(path)
+		e.getVault(w, m[1], now)
+	case itemsPath.MatchString(path) && r.Method == http.MethodGet:
+		m := itemsPath.FindStringSubmatch(path)
+		e.listItems(w, r, m[1], now)
+	case itemsPath.MatchString(path) && r.Method == http.MethodPost:
+		m := itemsPath.FindStringSubmatch(path)
+		e.createItem(w, r, m[1], now)
+	case itemPath.MatchString(path) && r.Method == http.MethodGet:
+		m := itemPath.FindStringSubmatch(path)
+		e.getItem(w, m[1], m[2], now)
+	case itemPath.MatchString(path) && r.Method == http.MethodPut:
+		m := itemPath.FindStringSubmatch(path)
+		e.updateItem(w, r, m[1], m[2], now)
+	case itemPath.MatchString(path) && r.Method == http.MethodDelete:
+		m := itemPath.FindStringSubmatch(path)
+		e.deleteItem(w, m[1], m[2], now)
+	case filesPath.MatchString(path) && r.Method == http.MethodGet:
+		m := filesPath.FindStringSubmatch(path)
+		e.listFiles(w, r, m[1], m[2], now)
+	case filePath.MatchString(path) && r.Method == http.MethodGet:
+		m := filePath.FindStringSubmatch(path)
+		e.getFile(w, r, m[1], m[2], m[3], now)
+	case fileContentPath.MatchString(path) && r.Method == http.MethodGet:
+		m := fileContentPath.FindStringSubmatch(path)
+		e.getFileContent(w, m[1], m[2], m[3], now)
+	default:
+		writeError(w, http.StatusNotFound, fmt.Sprintf("Unsupported request %s %s", r.Method, path))
+	}
+}
+
+func (e *Emulator) listVaults(w http.ResponseWriter, r *http.Request, now time.Time) {
+	title, ok := parseTitleFilter(w, r)
+	if !ok {
+		return
+	}
+
+	vaults := []onepassword.Vault{}
+	for _, record := range e.vaults {
+		if title == nil || record.vault.Name == *title {
+			vaults = append(vaults, e.vaultWithItemCount(record, now))
+		}
+	}
+	slices.SortFunc(vaults, func(a, b onepassword.Vault) int {
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
+	writeJSON(w, http.StatusOK, vaults)
+}
+
+func (e *Emulator) getVault(w http.ResponseWriter, vaultID string, now time.Time) {
+	record, ok := e.vaults[vaultID]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Invalid Vault UUID")
+		return
+	}
+	writeJSON(w, http.StatusOK, e.vaultWithItemCount(record, now))
+}
+
+func (e *Emulator) listItems(w http.ResponseWriter, r *http.Request, vaultID string, now time.Time) {
+	vault, ok := e.vaults[vaultID]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Invalid Vault UUID")
+		return
+	}
+	title, ok := parseTitleFilter(w, r)
+	if !ok {
+		return
+	}
+
+	items := []*onepassword.Item{}
+	for _, record := range vault.items {
+		item := record.read(now)
+		if item != nil && (title == nil || item.Title == *title) {
+			items = append(items, summarizeItem(item))
+		}
+	}
+	sortItems(items)
+	writeJSON(w, http.StatusOK, items)
+}
+
+func (e *Emulator) createItem(w http.ResponseWriter, r *http.Request, vaultID string, now time.Time) {
+	vault, ok := e.vaults[vaultID]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Invalid Vault UUID")
+		return
+	}
+	var item onepassword.Item
+	if err := json.NewDecoder(r.Body).Decode(&item); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("Invalid item: %s", err))
+		return
+	}
+	if item.Vault.ID != "" && item.Vault.ID != vaultID {
+		writeError(w, http.StatusBadRequest, "Item vault doesn't match the vault in the path")
+		return
+	}
+	item.Vault.ID = vaultID
+
+	created, err := newItem(&item, "")
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	// Connect can't upload files
+	created.Files = nil
+
+	record := &itemRecord{files: map[string][]byte{}}
+	record.write(created, now, e.config.ConsistencyDelay)
+	vault.items[created.ID] = record
+	writeJSON(w, http.StatusOK, created)
+}
+
+func (e *Emulator) getItem(w http.ResponseWriter, vaultID, itemID string, now time.Time) {
+	_, item, ok := e.visibleItem(w, vaultID, itemID, now)
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, item)
+}
+
+func (e *Emulator) updateItem(w http.ResponseWriter, r *http.Request, vaultID, itemID string, now time.Time) {
+	record, _, ok := e.visibleItem(w, vaultID, itemID, now)
+	if !ok {
+		return
+	}
+	var item onepassword.Item
+	if err := json.NewDecoder(r.Body).Decode(&item); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("Invalid item: %s", err))
+		return
+	}
+	if item.ID != itemID || (item.Vault.ID != "" && item.Vault.ID != vaultID) {
+		writeError(w, http.StatusBadRequest, "Item doesn't match the item in the path")
+		return
+	}
+	if record.item == nil {
+		writeError(w, http.StatusNotFound, "Invalid Item UUID")
+		return
+	}
+	// Updates are checked against the latest version, which may not be visible to reads yet
+	if item.Version != 0 && item.Version != record.item.Version {
+		writeError(w, http.StatusConflict, fmt.Sprintf("Item version conflict, item version is %d, expected %d", record.item.Version, item.Version))
+		return
+	}
+
+	item.Vault.ID = vaultID
+	updated, err := newItem(&item, itemID)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	updated.Version = record.item.Version + 1
+	updated.Favorite = record.item.Favorite
+	updated.CreatedAt = record.item.CreatedAt
+	// Files can't be changed through Connect
+	updated.Files = record.item.Files
+
+	record.write(updated, now, e.config.ConsistencyDelay)
+
+	// Like Connect, the response contains the version the item had before the update
+	response := cloneItem(updated)
+	response.Version = updated.Version - 1
+	writeJSON(w, http.StatusOK, response)
+}
+
+func (e *Emulator) deleteItem(w http.ResponseWriter, vaultID, itemID string, now time.Time) {
+	record, _, ok := e.visibleItem(w, vaultID, itemID, now)
+	if !ok {
+		return
+	}
+	record.write(nil, now, e.config.ConsistencyDelay)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (e *Emulator) listFiles(w http.ResponseWriter, r *http.Request, vaultID, itemID string, now time.Time) {
+	record, item, ok := e.visibleItem(w, vaultID, itemID, now)
+	if !ok {
+		return
+	}
+
+	files := []fileResponse{}
+	for _, file := range item.Files {
+		files = append(files, toFileResponse(file, record.files[file.ID], r.URL.Query().Get("inline_files") == "true"))
+	}
+	writeJSON(w, http.StatusOK, files)
+}
+
+func (e *Emulator) getFile(w http.ResponseWriter, r *http.Request, vaultID, itemID, fileID string, now time.Time) {
+	record, item, ok := e.visibleItem(w, vaultID, itemID, now)
+	if !ok {
+		return
+	}
+	i := slices.IndexFunc(item.Files, func(f *onepassword.File) bool { return f.ID == fileID })
+	if i == -1 {
+		writeError(w, http.StatusNotFound, "File not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, toFileResponse(item.Files[i], record.files[fileID], r.URL.Query().Get("inline_files") == "true"))
+}
+
+func (e *Emulator) getFileContent(w http.ResponseWriter, vaultID, itemID, fileID string, now time.Time) {
+	record, item, ok := e.visibleItem(w, vaultID, itemID, now)
+	if !ok {
+		return
+	}
+	if !slices.ContainsFunc(item.Files, func(f *onepassword.File) bool { return f.ID == fileID }) {
+		writeError(w, http.StatusNotFound, "File not found")
+		return
+	}
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(record.files[fileID])
+}
+
+// visibleItem looks up an item as seen by reads and writes a 404 response when it isn't found.
+func (e *Emulator) visibleItem(w http.ResponseWriter, vaultID, itemID string, now time.Time) (*itemRecord, *onepassword.Item, bool) {
+	vault, ok := e.vaults[vaultID]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Invalid Vault UUID")
+		return nil, nil, false
+	}
+	record, ok := vault.items[itemID]
+	if !ok || record.read(now) == nil {
+		writeError(w, http.StatusNotFound, "Invalid Item UUID")
+		return nil, nil, false
+	}
+	return record, record.read(now), true
+}
+
+func (e *Emulator) latestItem(vaultID, itemID string) (*itemRecord, error) {
+	vault, ok := e.vaults[vaultID]
+	if !ok {
+		return nil, fmt.Errorf("vault %q not found", vaultID)
+	}
+	record, ok := vault.items[itemID]
+	if !ok || record.item == nil {
+		return nil, fmt.Errorf("item %q not found in vault %q", itemID, vaultID)
+	}
+	return record, nil
+}
+
+func (e *Emulator) vaultWithItemCount(record *vaultRecord, now time.Time) onepassword.Vault {
+	vault := record.vault
+	vault.Items = 0
+	for _, item := range record.items {
+		if item.read(now) != nil {
+			vault.Items++
+		}
+	}
+	return vault
+}
+
+func (e *Emulator) matchFault(r *http.Request) *Fault {
+	for i, fault := range e.faults {
+		if fault.Method != "" && fault.Method != r.Method {
+			continue
+		}
+		if fault.Path != nil && !fault.Path.MatchString(r.URL.Path) {
+			continue
+		}
+
+		if fault.Count > 0 {
+			fault.Count--
+			if fault.Count == 0 {
+				e.faults = slices.Delete(e.faults, i, i+1)
+			}
+		}
+		if fault.Message == "" {
+			return &Fault{StatusCode: fault.StatusCode, Message: defaultMessage(fault.StatusCode)}
+		}
+		return fault
+	}
+	return nil
+}
+
+// defaultMessage returns the message Connect responds with for a status code.
+func defaultMessage(statusCode int) string {
+	if statusCode == http.StatusInternalServerError {
+		return "Something went wrong"
+	}
+	return http.StatusText(statusCode)
+}
+
+// parseTitleFilter returns the title of a `title eq "..."` filter, nil when there is no filter.
+// Other filters are rejected with a 400 response.
+func parseTitleFilter(w http.ResponseWriter, r *http.Request) (*string, bool) {
+	filter := r.URL.Query().Get("filter")
+	if filter == "" {
+		return nil, true
+	}
+	m := titleFilter.FindStringSubmatch(filter)
+	if m == nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("Unsupported filter %q", filter))
+		return nil, false
+	}
+	return &m[1], true
+}
+
+// newItem returns a copy of the item as stored when it is created or replaced, with generated IDs and field values.
+func newItem(item *onepassword.Item, id string) (*onepassword.Item, error) {
+	now := time.Now().UTC()
+	created := cloneItem(item)
+	created.ID = id
+	if created.ID == "" {
+		created.ID = newID()
+	}
+	created.Version = 1
+	created.CreatedAt = now
+	created.UpdatedAt = now
+
+	sectionIDs := map[string]bool{}
+	for _, s := range created.Sections {
+		if s.ID == "" {
+			s.ID = newID()
+		}
+		sectionIDs[s.ID] = true
+	}
+	for _, f := range created.Fields {
+		if f.ID == "" {
+			f.ID = newID()
+		}
+		if f.Section != nil && !sectionIDs[f.Section.ID] {
+			return nil, fmt.Errorf("Section %q of field %q not found", f.Section.ID, f.Label)
+		}
+		if f.Generate {
+			password, err := generateValue(f.Recipe)
+			if err != nil {
+				return nil, err
+			}
+			f.Value = password
+			f.Generate = false
+			f.Recipe = nil
+		}
+	}
+	return created, nil
+}
+
+// generateValue generates a field value for a Connect recipe, which uses letters and digits by default.
+func generateValue(recipe *onepassword.GeneratorRecipe) (string, error) {
+	characterSets := []string{"LETTERS", "DIGITS"}
+	length := 32
+	var excludeCharacters string
+	if recipe != nil {
+		if len(recipe.CharacterSets) > 0 {
+			characterSets = recipe.CharacterSets
+		}
+		if recipe.Length > 0 {
+			length = recipe.Length
+		}
+		excludeCharacters = recipe.ExcludeCharacters
+	}
+
+	modelRecipe := &model.GeneratorRecipe{
+		Type:              model.RecipeTypeRandom,
+		Length:            length,
+		ExcludeLetters:    !slices.Contains(characterSets, "LETTERS"),
+		ExcludeCharacters: excludeCharacters,
+	}
+	for _, characterSet := range characterSets {
+		switch characterSet {
+		case string(model.CharacterSetDigits):
+			modelRecipe.CharacterSets = append(modelRecipe.CharacterSets, model.CharacterSetDigits)
+		case string(model.CharacterSetSymbols):
+			modelRecipe.CharacterSets = append(modelRecipe.CharacterSets, model.CharacterSetSymbols)
+		}
+	}
+
+	password, err := model.GeneratePassword(modelRecipe)
+	if err != nil {
+		return "", fmt.Errorf("Unable to generate value: %s", err)
+	}
+	return password, nil
+}
+
+// summarizeItem returns the item as listed by Connect, without its sections, fields and files.
+func summarizeItem(item *onepassword.Item) *onepassword.Item {
+	summary := cloneItem(item)
+	summary.Sections = nil
+	summary.Fields = nil
+	summary.Files = nil
+	return summary
+}
+
+func sortItems(items []*onepassword.Item) {
+	slices.SortFunc(items, func(a, b *onepassword.Item) int {
+		if c := a.CreatedAt.Compare(b.CreatedAt); c != 0 {
+			return c
+		}
+		return strings.Compare(a.ID, b.ID)
+	})
+}
+
+// fileResponse is a file as returned by Connect, which includes its content when inline files are requested.
+type fileResponse struct {
+	ID          string                   `json:"id"`
+	Name        string                   `json:"name"`
+	Section     *onepassword.ItemSection `json:"section,omitempty"`
+	Size        int                      `json:"size"`
+	ContentPath string                   `json:"content_path"`
+	Content     []byte                   `json:"content,omitempty"`
+}
+
+func toFileResponse(file *onepassword.File, content []byte, inline bool) fileResponse {
+	response := fileResponse{
+		ID:          file.ID,
+		Name:        file.Name,
+		Section:     file.Section,
+		Size:        file.Size,
+		ContentPath: file.ContentPath,
+	}
+	if inline {
+		response.Content = content
+	}
+	return response
+}
+
+// cloneItem returns a deep copy of the item, so stored items can't be changed through returned ones.
+func cloneItem(item *onepassword.Item) *onepassword.Item {
+	content, err := json.Marshal(item)
+	if err != nil {
+		panic(fmt.Sprintf("failed to copy item: %s", err))
+	}
+	var clone onepassword.Item
+	if err := json.Unmarshal(content, &clone); err != nil {
+		panic(fmt.Sprintf("failed to copy item: %s", err))
+	}
+	return &clone
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeError(w http.ResponseWriter, statusCode int, message string) {
+	writeJSON(w, statusCode, onepassword.Error{StatusCode: statusCode, Message: message})
+}
+
+// newID returns an ID in the format 1Password uses, 26 lowercase letters and digits.
+func newID() string {
+	return strings.ToLower(rand.Text())
+}
@@ -0,0 +1,233 @@
+package connectemulator
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/1Password/connect-sdk-go/onepassword"
+
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/connect"
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/model"
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/util"
+)
+
+func setupEmulator(t *testing.T, config Config) (*Emulator, *connect.Client) {
+	t.Helper()
+
+	e := New(config)
+	server := httptest.NewServer(e)
+	t.Cleanup(server.Close)
+
+	return e, connect.NewClient(server.URL, config.Token, connect.Config{ProviderUserAgent: "terraform-provider-onepassword/test"})
+}
+
+func TestItemLifecycle(t *testing.T) {
+	ctx := context.Background()
+	e, client := setupEmulator(t, Config{Token: "token"})
+	vault := e.AddVault(onepassword.Vault{Name: "Test Vault"})
+	other := e.AddVault(onepassword.Vault{Name: "Other Vault"})
+
+	created, err := client.CreateItem(ctx, &model.Item{
+		Title:    "Test Item",
+		VaultID:  vault.ID,
+		Category: model.Login,
+		Sections: []model.ItemSection{{ID: "s1", Label: "API"}},
+		Fields: []model.ItemField{
+			{Label: "username", Purpose: model.FieldPurposeUsername, Type: model.FieldTypeString, Value: "admin"},
+			{Label: "password", Purpose: model.FieldPurposePassword, Type: model.FieldTypeConcealed, Generate: true, Recipe: &model.GeneratorRecipe{Length: 20}},
+			{Label: "token", Type: model.FieldTypeConcealed, Value: "secret", SectionID: "s1", SectionLabel: "API"},
+		},
+	}, vault.ID)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if created.Version != 1 || len(created.Fields) != 3 || len(created.Fields[1].Value) != 20 {
+		t.Fatalf("Unexpected created item %+v", created)
+	}
+	if _, err := client.CreateItem(ctx, &model.Item{Title: "Test Item", VaultID: other.ID, Category: model.Password}, other.ID); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	byTitle, err := client.GetItemByTitle(ctx, "Test Item", vault.ID)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if byTitle.ID != created.ID {
+		t.Errorf("Expected item %s looked up by title, got %s", created.ID, byTitle.ID)
+	}
+
+	created.Title = "Updated Item"
+	updated, err := client.UpdateItem(ctx, created, vault.ID)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if updated.Title != "Updated Item" || updated.Version != 2 {
+		t.Errorf("Unexpected title %q or version %d of updated item", updated.Title, updated.Version)
+	}
+
+	items, err := client.ListItems(ctx, vault.ID)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(items) != 1 || items[0].Title != "Updated Item" {
+		t.Errorf("Expected only the updated item to be listed, got %+v", items)
+	}
+
+	if err := client.DeleteItem(ctx, updated, vault.ID); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, ok := e.Item(vault.ID, created.ID); ok {
+		t.Errorf("Expected item to be deleted")
+	}
+	if len(e.Items(other.ID)) != 1 {
+		t.Errorf("Expected the item in the other vault to be kept")
+	}
+}
+
+func TestUpdateItemVersionConflict(t *testing.T) {
+	ctx := context.Background()
+	e, client := setupEmulator(t, Config{})
+	vault := e.AddVault(onepassword.Vault{Name: "Test Vault"})
+
+	created, err := client.CreateItem(ctx, &model.Item{Title: "Test Item", VaultID: vault.ID, Category: model.Password}, vault.ID)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, err := client.UpdateItem(ctx, created, vault.ID); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// created still has version 1 while the item is at version 2
+	if _, err := client.UpdateItem(ctx, created, vault.ID); !errors.Is(err, util.ErrConflict) {
+		t.Errorf("Expected version conflict, got %v", err)
+	}
+}
+
+func TestConsistencyDelay(t *testing.T) {
+	ctx := context.Background()
+	e, client := setupEmulator(t, Config{ConsistencyDelay: 300 * time.Millisecond})
+	vault := e.AddVault(onepassword.Vault{Name: "Test Vault"})
+
+	created, err := client.CreateItem(ctx, &model.Item{Title: "Test Item", VaultID: vault.ID, Category: model.Password}, vault.ID)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// The client waits until the update is visible before returning
+	created.Title = "Updated Item"
+	if _, err := client.UpdateItem(ctx, created, vault.ID); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	item, err := client.GetItem(ctx, created.ID, vault.ID)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if item.Title != "Updated Item" || item.Version != 2 {
+		t.Errorf("Expected updated item to be visible, got title %q and version %d", item.Title, item.Version)
+	}
+
+	e.SetConsistencyDelay(time.Hour)
+	if err := client.DeleteItem(ctx, item, vault.ID); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, err := client.GetItem(ctx, created.ID, vault.ID); err != nil {
+		t.Errorf("Expected deleted item to still be returned, got %v", err)
+	}
+}
+
+func TestInjectFault(t *testing.T) {
+	ctx := context.Background()
+	e, client := setupEmulator(t, Config{})
+	vault := e.AddVault(onepassword.Vault{Name: "Test Vault"})
+
+	created, err := client.CreateItem(ctx, &model.Item{Title: "Test Item", VaultID: vault.ID, Category: model.Password}, vault.ID)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// The client retries conflicts and, for deletes, internal server errors
+	e.InjectFault(Fault{Method: http.MethodPut, StatusCode: http.StatusConflict, Count: 2})
+	if _, err := client.UpdateItem(ctx, created, vault.ID); err != nil {
+		t.Fatalf("Expected update to succeed after conflicts, got %v", err)
+	}
+	e.InjectFault(Fault{Method: http.MethodDelete, Path: regexp.MustCompile("/items/"), StatusCode: http.StatusInternalServerError, Count: 1})
+	if err := client.DeleteItem(ctx, created, vault.ID); err != nil {
+		t.Fatalf("Expected delete to succeed after internal server error, got %v", err)
+	}
+
+	e.InjectFault(Fault{Method: http.MethodGet, StatusCode: http.StatusServiceUnavailable})
+	if _, err := client.GetVault(ctx, vault.ID); err == nil || !strings.Contains(err.Error(), "503") {
+		t.Errorf("Expected service unavailable error, got %v", err)
+	}
+	e.ClearFaults()
+	if _, err := client.GetVault(ctx, vault.ID); err != nil {
+		t.Errorf("Expected faults to be cleared, got %v", err)
+	}
+}
+
+func TestVaultsByTitle(t *testing.T) {
+	ctx := context.Background()
+	e, client := setupEmulator(t, Config{})
+	e.AddVault(onepassword.Vault{Name: "Test Vault"})
+	e.AddVault(onepassword.Vault{Name: "Other Vault"})
+
+	vaults, err := client.GetVaultsByTitle(ctx, "Other Vault")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(vaults) != 1 || vaults[0].Name != "Other Vault" {
+		t.Errorf("Expected only 'Other Vault', got %+v", vaults)
+	}
+}
+
+func TestFiles(t *testing.T) {
+	ctx := context.Background()
+	e, client := setupEmulator(t, Config{})
+	vault := e.AddVault(onepassword.Vault{Name: "Test Vault"})
+
+	item, err := e.AddItem(onepassword.Item{
+		Title:    "Test Item",
+		Vault:    onepassword.ItemVault{ID: vault.ID},
+		Category: onepassword.SecureNote,
+		Sections: []*onepassword.ItemSection{{ID: "s1", Label: "Files"}},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, err := e.AddFile(vault.ID, item.ID, onepassword.File{Name: "config.json", Section: &onepassword.ItemSection{ID: "s1"}}, []byte(`{"key":"value"}`)); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	read, err := client.GetItem(ctx, item.ID, vault.ID)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(read.Files) != 1 || read.Files[0].Size != 15 {
+		t.Fatalf("Unexpected files %+v", read.Files)
+	}
+	content, err := client.GetFileContent(ctx, &read.Files[0], item.ID, vault.ID)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if string(content) != `{"key":"value"}` {
+		t.Errorf("Unexpected file content %q", content)
+	}
+}
+
+func TestToken(t *testing.T) {
+	e := New(Config{Token: "token"})
+	vault := e.AddVault(onepassword.Vault{Name: "Test Vault"})
+	server := httptest.NewServer(e)
+	defer server.Close()
+
+	client := connect.NewClient(server.URL, "wrong", connect.Config{ProviderUserAgent: "terraform-provider-onepassword/test"})
+	if _, err := client.GetVault(context.Background(), vault.ID); !errors.Is(err, util.ErrUnauthorized) {
+		t.Errorf("Expected unauthorized error, got %v", err)
+	}
+}
@@ -2,3 +2,7 @@ data "onepassword_item" "example" {
   vault = "your-vault-id"
   title = "your-item-title"
 }
+
+output "example_item_updated_at" {
+  value = data.onepassword_item.example.updated_at
+}
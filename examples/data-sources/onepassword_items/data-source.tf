@@ -0,0 +1,12 @@
+data "onepassword_items" "prod_databases" {
+  vault    = "your-vault-id"
+  category = "database"
+  tag      = "prod"
+}
+
+data "onepassword_item" "prod_database" {
+  for_each = { for item in data.onepassword_items.prod_databases.items : item.title => item.uuid }
+
+  vault = "your-vault-id"
+  uuid  = each.value
+}
@@ -0,0 +1,7 @@
+data "onepassword_vaults" "production" {
+  name_prefix = "prod-"
+}
+
+output "production_vault_ids" {
+  value = { for vault in data.onepassword_vaults.production.vaults : vault.name => vault.uuid }
+}
@@ -0,0 +1,12 @@
+# Read environment variables from a 1Password Environment without storing them in state.
+# Requires service account or desktop app authentication (not 1Password Connect).
+ephemeral "onepassword_environment" "example" {
+  environment_id = "your-environment-id"
+}
+
+# Use a variable in a provider configuration
+provider "postgresql" {
+  host     = "db.example.com"
+  username = "app"
+  password = ephemeral.onepassword_environment.example.variables["DB_PASSWORD"]
+}
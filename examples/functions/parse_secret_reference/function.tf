@@ -0,0 +1,9 @@
+locals {
+  reference = provider::onepassword::parse_secret_reference("op://Production/Database/admin/password")
+}
+
+# Read the referenced item using the parsed components
+data "onepassword_item" "database" {
+  vault = local.reference.vault
+  title = local.reference.item
+}
@@ -41,8 +41,10 @@ resource "onepassword_item" "example_with_list" {
   category = "login"
 
   password_recipe {
-    length  = 40
-    symbols = false
+    length             = 40
+    symbols            = false
+    min_digits         = 4
+    exclude_characters = "0O1lI"
   }
 
   section {
@@ -55,3 +57,151 @@ resource "onepassword_item" "example_with_list" {
     }
   }
 }
+
+# Example using memorable passphrase and PIN recipes
+resource "onepassword_item" "example_with_passphrase" {
+  vault = "your-vault-id"
+
+  title    = "Example Item with Passphrase"
+  category = "login"
+
+  password_recipe {
+    type       = "memorable"
+    word_count = 5
+    separator  = "periods"
+    capitalize = true
+  }
+
+  section_map = {
+    "device" = {
+      field_map = {
+        "pin" = {
+          type = "CONCEALED"
+          password_recipe = {
+            type   = "pin"
+            length = 6
+          }
+        }
+      }
+    }
+  }
+}
+
+# Example generating an SSH key
+resource "onepassword_item" "example_ssh_key" {
+  vault = "your-vault-id"
+
+  title             = "Example SSH Key"
+  category          = "ssh_key"
+  ssh_key_algorithm = "ed25519"
+}
+
+# The public key can be used directly in cloud key-pair resources
+output "example_ssh_public_key" {
+  value = onepassword_item.example_ssh_key.public_key
+}
+
+# Example API credential
+resource "onepassword_item" "example_api_credential" {
+  vault = "your-vault-id"
+
+  title      = "Example API Credential"
+  category   = "api_credential"
+  username   = "service-account"
+  credential = "your-api-token"
+  type       = "bearer"
+  hostname   = "api.example.com"
+  valid_from = "2025-01-01"
+  expires    = "2026-01-01"
+}
+
+# Example document uploaded from a local file
+resource "onepassword_item" "example_document" {
+  vault = "your-vault-id"
+
+  title    = "Example Kubeconfig"
+  category = "document"
+  filename = "kubeconfig.yaml"
+  source   = "${path.module}/kubeconfig.yaml"
+}
+
+# Example attaching files to a section
+resource "onepassword_item" "example_with_files" {
+  vault = "your-vault-id"
+
+  title    = "Example Item with Files"
+  category = "secure_note"
+
+  section {
+    label = "TLS"
+
+    file {
+      name   = "ca.pem"
+      source = "${path.module}/ca.pem"
+    }
+  }
+}
+
+# Example credit card using the built-in fields of the category
+resource "onepassword_item" "example_credit_card" {
+  vault = "your-vault-id"
+
+  title    = "Example Credit Card"
+  category = "credit_card"
+
+  category_fields = {
+    cardholder = "Wendy Appleseed"
+    ccnum      = "4111111111111111"
+    cvv        = "123"
+    expiry     = "202712"
+  }
+}
+
+# Example login with several websites
+resource "onepassword_item" "example_with_websites" {
+  vault = "your-vault-id"
+
+  title    = "Example Multi-Domain Login"
+  category = "login"
+  username = "wendy@example.com"
+
+  website {
+    url = "https://example.com"
+  }
+
+  website {
+    url               = "https://login.example.com"
+    label             = "sign-in address"
+    primary           = true
+    autofill_behavior = "exact_domain"
+  }
+}
+
+# Example failing updates when the item was changed in 1Password since the last refresh
+resource "onepassword_item" "example_with_conflict_detection" {
+  vault = "your-vault-id"
+
+  title              = "Example Shared Database"
+  category           = "database"
+  username           = "admin"
+  conflict_detection = true
+}
+
+# Example only updating the declared fields, keeping the ones added in the 1Password app
+resource "onepassword_item" "example_with_managed_fields" {
+  vault = "your-vault-id"
+
+  title           = "Example Shared Login"
+  category        = "login"
+  username        = "wendy@example.com"
+  field_ownership = "managed_only"
+
+  section {
+    label = "Deployment"
+
+    field {
+      label = "environment"
+      value = "production"
+    }
+  }
+}
@@ -0,0 +1,5 @@
+# import an existing field in a section of a 1Password item
+terraform import onepassword_item_field.api_token "vaults/<vault uuid>/items/<item uuid>/sections/<section label>/fields/<field label>"
+
+# import an existing field outside of sections
+terraform import onepassword_item_field.username "vaults/<vault uuid>/items/<item uuid>/fields/<field label>"
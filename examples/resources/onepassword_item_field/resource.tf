@@ -0,0 +1,9 @@
+# Manage a rotated API token in an item that is otherwise maintained in the 1Password app
+resource "onepassword_item_field" "api_token" {
+  vault   = "your-vault-id"
+  item    = "your-item-id"
+  section = "API"
+  label   = "token"
+  type    = "CONCEALED"
+  value   = var.rotated_api_token
+}
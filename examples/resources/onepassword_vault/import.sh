@@ -0,0 +1,2 @@
+# import an existing 1Password vault
+terraform import onepassword_vault.myvault vaults/<vault uuid>
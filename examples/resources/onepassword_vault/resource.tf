@@ -0,0 +1,4 @@
+resource "onepassword_vault" "example" {
+  name        = "Example Vault"
+  description = "Vault managed by Terraform"
+}
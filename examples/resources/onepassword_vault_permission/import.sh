@@ -0,0 +1,2 @@
+# import an existing group permission on a 1Password vault
+terraform import onepassword_vault_permission.developers vaults/<vault uuid>/groups/<group uuid>
@@ -0,0 +1,14 @@
+resource "onepassword_vault" "example" {
+  name = "Example Vault"
+}
+
+resource "onepassword_vault_permission" "developers" {
+  vault = onepassword_vault.example.uuid
+  group = "<group uuid>"
+  permissions = [
+    "read_items",
+    "reveal_item_password",
+    "create_items",
+    "update_items",
+  ]
+}
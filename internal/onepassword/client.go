@@ -5,22 +5,43 @@ import (
 	"errors"
 
 	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/connect"
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/fake"
 	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/model"
 	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/sdk"
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/util"
 )
 
 // Client is a subset of connect.Client with context added.
 type Client interface {
 	GetVault(ctx context.Context, uuid string) (*model.Vault, error)
+	GetVaults(ctx context.Context) ([]model.Vault, error)
 	GetVaultsByTitle(ctx context.Context, title string) ([]model.Vault, error)
+	// CreateVault, UpdateVault and DeleteVault manage vaults. Only supported when using the 1Password SDK (service account or desktop app); not supported with 1Password Connect.
+	CreateVault(ctx context.Context, vault *model.Vault) (*model.Vault, error)
+	UpdateVault(ctx context.Context, vault *model.Vault) (*model.Vault, error)
+	DeleteVault(ctx context.Context, vaultUuid string) error
+	// GetVaultPermissions, GrantVaultGroupPermissions, UpdateVaultGroupPermissions and RevokeVaultGroupPermissions manage vault access. Only supported when using the 1Password SDK (service account or desktop app); not supported with 1Password Connect.
+	GetVaultPermissions(ctx context.Context, vaultUuid string) ([]model.VaultAccess, error)
+	GrantVaultGroupPermissions(ctx context.Context, access model.VaultAccess) error
+	UpdateVaultGroupPermissions(ctx context.Context, access model.VaultAccess) error
+	RevokeVaultGroupPermissions(ctx context.Context, vaultUuid, groupUuid string) error
 	GetItem(ctx context.Context, itemUuid, vaultUuid string) (*model.Item, error)
 	GetItemByTitle(ctx context.Context, title string, vaultUuid string) (*model.Item, error)
+	// ListItems returns the overviews of all items in a vault. Overviews never contain secret values.
+	ListItems(ctx context.Context, vaultUuid string) ([]model.ItemOverview, error)
 	CreateItem(ctx context.Context, item *model.Item, vaultUuid string) (*model.Item, error)
+	// UpdateItem fails without changing the item when the version of the given item is set and differs from its current version.
 	UpdateItem(ctx context.Context, item *model.Item, vaultUuid string) (*model.Item, error)
 	DeleteItem(ctx context.Context, item *model.Item, vaultUuid string) error
 	GetFileContent(ctx context.Context, file *model.ItemFile, itemUUid, vaultUuid string) ([]byte, error)
+	// UploadFile and DeleteFile attach and remove files in the sections of an item and ReplaceDocument replaces the file of a document item. Only supported when using the 1Password SDK (service account or desktop app); not supported with 1Password Connect.
+	UploadFile(ctx context.Context, item *model.Item, file *model.ItemFile, vaultUuid string) (*model.Item, error)
+	DeleteFile(ctx context.Context, item *model.Item, file *model.ItemFile, vaultUuid string) (*model.Item, error)
+	ReplaceDocument(ctx context.Context, item *model.Item, file *model.ItemFile, vaultUuid string) (*model.Item, error)
 	// GetEnvironmentVariables reads variables from a 1Password Environment. Only supported when using the 1Password SDK (service account or desktop app); not supported with 1Password Connect.
 	GetEnvironmentVariables(ctx context.Context, environmentID string) ([]model.EnvironmentVariable, error)
+	// RetryPolicy returns the policy the client retries requests with, for callers that retry operations made of several requests.
+	RetryPolicy() util.RetryPolicy
 }
 
 type ClientConfig struct {
@@ -29,18 +50,49 @@ type ClientConfig struct {
 	ServiceAccountToken string
 	Account             string
 	ProviderUserAgent   string
+	// Retry configures how requests to Connect and the SDK are retried.
+	Retry util.RetryPolicy
+	// RateLimit limits the requests made to 1Password when set. Rate limited requests are then only retried by the
+	// rate limiter, regardless of Retry.RetryRateLimited.
+	RateLimit *RateLimitConfig
+	// FakeBackend selects the in-memory backend, which is kept in FakeBackendDataFile when set.
+	FakeBackend         bool
+	FakeBackendDataFile string
 }
 
 func NewClient(ctx context.Context, config ClientConfig) (Client, error) {
-	if config.ServiceAccountToken != "" || config.Account != "" {
+	// Don't retry rate limited requests in the backend as well, which would multiply the waits
+	if config.RateLimit != nil {
+		config.Retry.RetryRateLimited = false
+	}
+
+	client, err := newBackendClient(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+	if config.RateLimit != nil {
+		return newRateLimitedClient(client, *config.RateLimit), nil
+	}
+	return client, nil
+}
+
+func newBackendClient(ctx context.Context, config ClientConfig) (Client, error) {
+	if config.FakeBackend {
+		return fake.NewClient(fake.Config{
+			DataFile: config.FakeBackendDataFile,
+			Retry:    config.Retry,
+		})
+	} else if config.ServiceAccountToken != "" || config.Account != "" {
 		return sdk.NewClient(ctx, sdk.SDKConfig{
 			ProviderUserAgent:   config.ProviderUserAgent,
 			ServiceAccountToken: config.ServiceAccountToken,
 			Account:             config.Account,
+			Retry:               config.Retry,
 		})
 	} else if config.ConnectHost != "" && config.ConnectToken != "" {
 		return connect.NewClient(config.ConnectHost, config.ConnectToken, connect.Config{
 			ProviderUserAgent: config.ProviderUserAgent,
+			Retry:             config.Retry,
 		}), nil
 	}
 	return nil, errors.New("Invalid provider configuration. Either Connect credentials (\"connect_token\" and \"connect_url\") or Service Account (\"service_account_token\") or \"account\"  should be set.")
@@ -2,8 +2,9 @@ package connect
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"strings"
+	"net/http"
 
 	"github.com/1Password/connect-sdk-go/connect"
 	"github.com/1Password/connect-sdk-go/onepassword"
@@ -12,8 +13,13 @@ import (
 	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/util"
 )
 
+var errVaultManagementNotSupported = util.NewError(util.ErrUnsupportedByBackend, errors.New("managing vaults and vault permissions is only supported when using service account or desktop app authentication; it is not available with 1Password Connect"))
+var errFileUploadNotSupported = util.NewError(util.ErrUnsupportedByBackend, errors.New("uploading files is only supported when using service account or desktop app authentication; it is not available with 1Password Connect"))
+var errEnvironmentsNotSupported = util.NewError(util.ErrUnsupportedByBackend, errors.New("1Password Environments are only supported when using service account or desktop app authentication; they are not available with 1Password Connect"))
+
 type Config struct {
 	ProviderUserAgent string
+	Retry             util.RetryPolicy
 }
 
 type Client struct {
@@ -24,7 +30,7 @@ type Client struct {
 func (c *Client) GetVault(_ context.Context, uuid string) (*model.Vault, error) {
 	connectVault, err := c.connectClient.GetVault(uuid)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get vault using connect: %w", err)
+		return nil, fmt.Errorf("failed to get vault using connect: %w", connectError(err))
 	}
 
 	modelVault := &model.Vault{}
@@ -32,10 +38,23 @@ func (c *Client) GetVault(_ context.Context, uuid string) (*model.Vault, error)
 	return modelVault, nil
 }
 
+func (c *Client) GetVaults(_ context.Context) ([]model.Vault, error) {
+	connectVaults, err := c.connectClient.GetVaults()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get vaults using connect: %w", connectError(err))
+	}
+
+	modelVaults := make([]model.Vault, len(connectVaults))
+	for i, connectVault := range connectVaults {
+		modelVaults[i].FromConnectVault(&connectVault)
+	}
+	return modelVaults, nil
+}
+
 func (c *Client) GetVaultsByTitle(_ context.Context, title string) ([]model.Vault, error) {
 	connectVaults, err := c.connectClient.GetVaultsByTitle(title)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get vault using connect: %w", err)
+		return nil, fmt.Errorf("failed to get vault using connect: %w", connectError(err))
 	}
 
 	modelVaults := make([]model.Vault, len(connectVaults))
@@ -47,6 +66,41 @@ func (c *Client) GetVaultsByTitle(_ context.Context, title string) ([]model.Vaul
 	return modelVaults, nil
 }
 
+// CreateVault returns an error because the Connect API does not support managing vaults.
+func (c *Client) CreateVault(_ context.Context, _ *model.Vault) (*model.Vault, error) {
+	return nil, errVaultManagementNotSupported
+}
+
+// UpdateVault returns an error because the Connect API does not support managing vaults.
+func (c *Client) UpdateVault(_ context.Context, _ *model.Vault) (*model.Vault, error) {
+	return nil, errVaultManagementNotSupported
+}
+
+// DeleteVault returns an error because the Connect API does not support managing vaults.
+func (c *Client) DeleteVault(_ context.Context, _ string) error {
+	return errVaultManagementNotSupported
+}
+
+// GetVaultPermissions returns an error because the Connect API does not support managing vault access.
+func (c *Client) GetVaultPermissions(_ context.Context, _ string) ([]model.VaultAccess, error) {
+	return nil, errVaultManagementNotSupported
+}
+
+// GrantVaultGroupPermissions returns an error because the Connect API does not support managing vault access.
+func (c *Client) GrantVaultGroupPermissions(_ context.Context, _ model.VaultAccess) error {
+	return errVaultManagementNotSupported
+}
+
+// UpdateVaultGroupPermissions returns an error because the Connect API does not support managing vault access.
+func (c *Client) UpdateVaultGroupPermissions(_ context.Context, _ model.VaultAccess) error {
+	return errVaultManagementNotSupported
+}
+
+// RevokeVaultGroupPermissions returns an error because the Connect API does not support managing vault access.
+func (c *Client) RevokeVaultGroupPermissions(_ context.Context, _, _ string) error {
+	return errVaultManagementNotSupported
+}
+
 // GetItem looks up an item by UUID (with retries) or by title.
 // If itemUuid is a valid UUID format, it attempts to fetch the item by UUID with retries
 // to handle eventual consistency issues in Connect (there can be a delay between item creation
@@ -57,14 +111,14 @@ func (c *Client) GetItem(_ context.Context, itemUuid, vaultUuid string) (*model.
 
 	if util.IsValidUUID(itemUuid) {
 		// Try GetItemByUUID with retry for eventual consistency
-		err := util.Retry404UntilCondition(context.Background(), func() (bool, error) {
+		err := util.Retry404UntilCondition(context.Background(), c.config.Retry, func() (bool, error) {
 			var fetchErr error
 			connectItem, fetchErr = c.connectClient.GetItemByUUID(itemUuid, vaultUuid)
 			if fetchErr == nil && connectItem != nil {
 				return true, nil
 			}
 			// Return the error (404 will be retried, others returned immediately)
-			return false, fetchErr
+			return false, connectError(fetchErr)
 		})
 
 		if err != nil {
@@ -84,7 +138,7 @@ func (c *Client) GetItem(_ context.Context, itemUuid, vaultUuid string) (*model.
 	// Not a UUID, use GetItemByTitle
 	connectItem, err := c.connectClient.GetItemByTitle(itemUuid, vaultUuid)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get item using connect: %w", err)
+		return nil, fmt.Errorf("failed to get item using connect: %w", connectError(err))
 	}
 
 	// Convert to model Item
@@ -100,7 +154,7 @@ func (c *Client) GetItem(_ context.Context, itemUuid, vaultUuid string) (*model.
 func (c *Client) GetItemByTitle(_ context.Context, title string, vaultUuid string) (*model.Item, error) {
 	connectItem, err := c.connectClient.GetItemByTitle(title, vaultUuid)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get item using connect: %w", err)
+		return nil, fmt.Errorf("failed to get item using connect: %w", connectError(err))
 	}
 
 	// Convert to model Item
@@ -113,7 +167,26 @@ func (c *Client) GetItemByTitle(_ context.Context, title string, vaultUuid strin
 	return modelItem, nil
 }
 
+func (c *Client) ListItems(_ context.Context, vaultUuid string) ([]model.ItemOverview, error) {
+	connectItems, err := c.connectClient.GetItems(vaultUuid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list items using connect: %w", connectError(err))
+	}
+
+	result := make([]model.ItemOverview, len(connectItems))
+	for i, connectItem := range connectItems {
+		result[i].FromConnectItemOverview(&connectItem)
+	}
+
+	return result, nil
+}
+
 func (c *Client) CreateItem(ctx context.Context, item *model.Item, vaultUuid string) (*model.Item, error) {
+	// Connect has no API to upload the file of a document item
+	if item.Category == model.Document {
+		return nil, errFileUploadNotSupported
+	}
+
 	// Convert model Item to Connect Item
 	connectItem, err := item.FromModelItemToConnect()
 	if err != nil {
@@ -121,10 +194,10 @@ func (c *Client) CreateItem(ctx context.Context, item *model.Item, vaultUuid str
 	}
 
 	var createdItem *onepassword.Item
-	err = util.RetryOnConflict(ctx, func() error {
+	err = util.RetryOnConflict(ctx, c.config.Retry, func() error {
 		var createErr error
 		createdItem, createErr = c.connectClient.CreateItem(connectItem, vaultUuid)
-		return createErr
+		return connectError(createErr)
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to create item using connect: %w", err)
@@ -133,11 +206,11 @@ func (c *Client) CreateItem(ctx context.Context, item *model.Item, vaultUuid str
 	// The sync service needs time to sync changes from the remote service to the local database.
 	// Verify the item exists (newly created items have version 1).
 	// Ignore errors from Retry404UntilCondition - if create succeeded, we return the created item even if retry times out
-	_ = util.Retry404UntilCondition(ctx, func() (bool, error) {
+	_ = util.Retry404UntilCondition(ctx, c.config.Retry, func() (bool, error) {
 		fetchedItem, err := c.connectClient.GetItemByUUID(createdItem.ID, vaultUuid)
 		if err != nil {
 			// 404 will be retried, others returned immediately
-			return false, err
+			return false, connectError(err)
 		}
 		// Item exists, check if it has version 1 (newly created)
 		if fetchedItem != nil && fetchedItem.Version == 1 {
@@ -145,7 +218,7 @@ func (c *Client) CreateItem(ctx context.Context, item *model.Item, vaultUuid str
 		}
 
 		// Item exists but version doesn't match yet, continue retrying with "condition not met" error
-		return false, fmt.Errorf("condition not met: item version is %d, expected 1", fetchedItem.Version)
+		return false, fmt.Errorf("%w: item version is %d, expected 1", util.ErrConditionNotMet, fetchedItem.Version)
 	})
 
 	// Convert created Connect Item back to model Item
@@ -158,6 +231,17 @@ func (c *Client) CreateItem(ctx context.Context, item *model.Item, vaultUuid str
 }
 
 func (c *Client) UpdateItem(ctx context.Context, item *model.Item, vaultUuid string) (*model.Item, error) {
+	// Only overwrite the expected version of the item when one is given
+	if item.Version != 0 {
+		currentItem, err := c.connectClient.GetItemByUUID(item.ID, vaultUuid)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read item using connect: %w", connectError(err))
+		}
+		if currentItem.Version != item.Version {
+			return nil, util.NewError(util.ErrConflict, fmt.Errorf("failed to update item using connect: version conflict, item version is %d, expected %d", currentItem.Version, item.Version))
+		}
+	}
+
 	// Convert model Item to Connect Item
 	connectItem, err := item.FromModelItemToConnect()
 	if err != nil {
@@ -165,10 +249,10 @@ func (c *Client) UpdateItem(ctx context.Context, item *model.Item, vaultUuid str
 	}
 
 	var updatedItem *onepassword.Item
-	err = util.RetryOnConflict(ctx, func() error {
+	err = util.RetryOnConflict(ctx, c.config.Retry, func() error {
 		var updateErr error
 		updatedItem, updateErr = c.connectClient.UpdateItem(connectItem, vaultUuid)
-		return updateErr
+		return connectError(updateErr)
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to update item using connect: %w", err)
@@ -179,18 +263,18 @@ func (c *Client) UpdateItem(ctx context.Context, item *model.Item, vaultUuid str
 	// Wait for Connect to propagate the update to the local SQLite database.
 	// The sync service needs time to sync changes from the remote service to the local database.
 	// Use Retry404UntilCondition to retry until the item version matches the expected version.
-	err = util.Retry404UntilCondition(ctx, func() (bool, error) {
+	err = util.Retry404UntilCondition(ctx, c.config.Retry, func() (bool, error) {
 		fetchedItem, err := c.connectClient.GetItemByUUID(updatedItem.ID, vaultUuid)
 		if err != nil {
 			// Return error immediately - don't retry
-			return false, err
+			return false, connectError(err)
 		}
 		// Compare versions to verify the update has propagated
 		if fetchedItem != nil && fetchedItem.Version == expectedVersion {
 			return true, nil
 		}
 		// Version doesn't match yet, continue retrying with "condition not met" error
-		return false, fmt.Errorf("condition not met: item version is %d, expected %d", fetchedItem.Version, expectedVersion)
+		return false, fmt.Errorf("%w: item version is %d, expected %d", util.ErrConditionNotMet, fetchedItem.Version, expectedVersion)
 	})
 	if err != nil {
 		return nil, err
@@ -202,6 +286,7 @@ func (c *Client) UpdateItem(ctx context.Context, item *model.Item, vaultUuid str
 	if err != nil {
 		return nil, err
 	}
+	modelItem.Version = expectedVersion
 	return modelItem, nil
 }
 
@@ -212,8 +297,8 @@ func (c *Client) DeleteItem(ctx context.Context, item *model.Item, vaultUuid str
 		return err
 	}
 
-	err = util.Retry500ForConnectDelete(ctx, func() error {
-		return c.connectClient.DeleteItem(connectItem, vaultUuid)
+	err = util.Retry500ForConnectDelete(ctx, c.config.Retry, func() error {
+		return connectError(c.connectClient.DeleteItem(connectItem, vaultUuid))
 	})
 	if err != nil {
 		return fmt.Errorf("failed to delete item using connect: %w", err)
@@ -223,26 +308,32 @@ func (c *Client) DeleteItem(ctx context.Context, item *model.Item, vaultUuid str
 	// The sync service needs time to sync changes from the remote service to the local database.
 	// Verify the item is deleted by checking it returns 404.
 	// Ignore errors from Retry404UntilCondition - if delete succeeded, we return nil even if retry times out
-	_ = util.Retry404UntilCondition(ctx, func() (bool, error) {
+	_ = util.Retry404UntilCondition(ctx, c.config.Retry, func() (bool, error) {
 		_, err := c.connectClient.GetItemByUUID(item.ID, vaultUuid)
 		if err != nil {
+			err = connectError(err)
 			// 404 means item is deleted, which is what we want
-			if strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "not found") {
+			if errors.Is(err, util.ErrNotFound) {
 				return true, nil
 			}
 			// Other errors are not retryable
 			return false, err
 		}
 		// Item still exists, deletion hasn't propagated yet so retry with "condition not met" error
-		return false, fmt.Errorf("condition not met: item still exists")
+		return false, fmt.Errorf("%w: item still exists", util.ErrConditionNotMet)
 	})
 
 	return nil
 }
 
+// RetryPolicy returns the retry policy the client was configured with.
+func (c *Client) RetryPolicy() util.RetryPolicy {
+	return c.config.Retry
+}
+
 // GetEnvironmentVariables returns an error because 1Password Environments are only supported when using the 1Password SDK (service account or desktop app authentication), not with 1Password Connect.
 func (c *Client) GetEnvironmentVariables(_ context.Context, _ string) ([]model.EnvironmentVariable, error) {
-	return nil, fmt.Errorf("1Password Environments are only supported when using service account or desktop app authentication; they are not available with 1Password Connect")
+	return nil, errEnvironmentsNotSupported
 }
 
 func (c *Client) GetFileContent(_ context.Context, file *model.ItemFile, itemUUID, vaultUUID string) ([]byte, error) {
@@ -263,7 +354,45 @@ func (c *Client) GetFileContent(_ context.Context, file *model.ItemFile, itemUUI
 	}
 
 	content, err := c.connectClient.GetFileContent(connectFile)
-	return content, err
+	return content, connectError(err)
+}
+
+// UploadFile returns an error because the Connect API does not support uploading files.
+func (c *Client) UploadFile(_ context.Context, _ *model.Item, _ *model.ItemFile, _ string) (*model.Item, error) {
+	return nil, errFileUploadNotSupported
+}
+
+// DeleteFile returns an error because the Connect API does not support managing files.
+func (c *Client) DeleteFile(_ context.Context, _ *model.Item, _ *model.ItemFile, _ string) (*model.Item, error) {
+	return nil, errFileUploadNotSupported
+}
+
+// ReplaceDocument returns an error because the Connect API does not support uploading files.
+func (c *Client) ReplaceDocument(_ context.Context, _ *model.Item, _ *model.ItemFile, _ string) (*model.Item, error) {
+	return nil, errFileUploadNotSupported
+}
+
+// connectError marks err with the kind of error matching the status code of the Connect API response.
+func connectError(err error) error {
+	var connectErr *onepassword.Error
+	if !errors.As(err, &connectErr) {
+		return err
+	}
+
+	switch connectErr.StatusCode {
+	case http.StatusNotFound:
+		return util.NewError(util.ErrNotFound, err)
+	case http.StatusConflict:
+		return util.NewError(util.ErrConflict, err)
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return util.NewError(util.ErrUnauthorized, err)
+	case http.StatusTooManyRequests:
+		return util.NewError(util.ErrRateLimited, err)
+	}
+	if connectErr.StatusCode >= http.StatusInternalServerError {
+		return util.NewError(util.ErrServerError, err)
+	}
+	return err
 }
 
 func NewClient(connectHost, connectToken string, config Config) *Client {
@@ -0,0 +1,618 @@
+package fake
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/model"
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/util"
+)
+
+// defaultRecipe is used for fields that are generated without a recipe, like 1Password does.
+var defaultRecipe = &model.GeneratorRecipe{
+	Type:          model.RecipeTypeRandom,
+	Length:        32,
+	CharacterSets: []model.CharacterSet{model.CharacterSetDigits, model.CharacterSetSymbols},
+}
+
+// Client is an in-memory 1Password backend that doesn't need a 1Password account.
+// It supports everything the SDK and Connect clients support. When a data file is given,
+// the data is loaded from it and written back after every change, so it is kept between provider runs.
+type Client struct {
+	mu       sync.Mutex
+	dataFile string
+	data     *data
+	retry    util.RetryPolicy
+}
+
+type Config struct {
+	// DataFile is the JSON file the data is loaded from and stored in. The data is only kept in memory when empty.
+	DataFile string
+	// Retry is returned by RetryPolicy. The in-memory backend doesn't fail requests that could be retried.
+	Retry util.RetryPolicy
+}
+
+func NewClient(config Config) (*Client, error) {
+	d, err := loadData(config.DataFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Client{
+		dataFile: config.DataFile,
+		data:     d,
+		retry:    config.Retry,
+	}, nil
+}
+
+func (c *Client) RetryPolicy() util.RetryPolicy {
+	return c.retry
+}
+
+func (c *Client) GetVault(_ context.Context, uuid string) (*model.Vault, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	vault, err := c.vault(uuid)
+	if err != nil {
+		return nil, err
+	}
+	return c.vaultWithItemCount(vault), nil
+}
+
+func (c *Client) GetVaults(_ context.Context) ([]model.Vault, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	result := make([]model.Vault, 0, len(c.data.Vaults))
+	for _, vault := range c.data.Vaults {
+		result = append(result, *c.vaultWithItemCount(vault))
+	}
+	slices.SortFunc(result, func(a, b model.Vault) int {
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
+	return result, nil
+}
+
+func (c *Client) GetVaultsByTitle(ctx context.Context, title string) ([]model.Vault, error) {
+	vaults, err := c.GetVaults(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []model.Vault
+	for _, vault := range vaults {
+		if vault.Name == title {
+			result = append(result, vault)
+		}
+	}
+	return result, nil
+}
+
+func (c *Client) CreateVault(_ context.Context, vault *model.Vault) (*model.Vault, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now().UTC()
+	created := &model.Vault{
+		ID:          newID(),
+		Name:        vault.Name,
+		Description: vault.Description,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	c.data.Vaults[created.ID] = created
+	c.data.Items[created.ID] = map[string]*model.Item{}
+
+	if err := c.save(); err != nil {
+		return nil, err
+	}
+	return c.vaultWithItemCount(created), nil
+}
+
+func (c *Client) UpdateVault(_ context.Context, vault *model.Vault) (*model.Vault, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	current, err := c.vault(vault.ID)
+	if err != nil {
+		return nil, err
+	}
+	current.Name = vault.Name
+	current.Description = vault.Description
+	current.UpdatedAt = time.Now().UTC()
+
+	if err := c.save(); err != nil {
+		return nil, err
+	}
+	return c.vaultWithItemCount(current), nil
+}
+
+func (c *Client) DeleteVault(_ context.Context, vaultUuid string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	vault, err := c.vault(vaultUuid)
+	if err != nil {
+		return err
+	}
+	for _, item := range c.data.Items[vault.ID] {
+		c.deleteFileContents(item.Files)
+	}
+	delete(c.data.Vaults, vault.ID)
+	delete(c.data.Items, vault.ID)
+	delete(c.data.Permissions, vault.ID)
+
+	return c.save()
+}
+
+func (c *Client) GetVaultPermissions(_ context.Context, vaultUuid string) ([]model.VaultAccess, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	vault, err := c.vault(vaultUuid)
+	if err != nil {
+		return nil, err
+	}
+	return slices.Clone(c.data.Permissions[vault.ID]), nil
+}
+
+func (c *Client) GrantVaultGroupPermissions(_ context.Context, access model.VaultAccess) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	vault, err := c.vault(access.VaultID)
+	if err != nil {
+		return err
+	}
+	access.VaultID = vault.ID
+	if c.groupAccessIndex(access.VaultID, access.AccessorID) != -1 {
+		return fmt.Errorf("group %q already has access to vault %q", access.AccessorID, access.VaultID)
+	}
+	access.AccessorType = model.VaultAccessorTypeGroup
+	c.data.Permissions[access.VaultID] = append(c.data.Permissions[access.VaultID], access)
+
+	return c.save()
+}
+
+func (c *Client) UpdateVaultGroupPermissions(_ context.Context, access model.VaultAccess) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	vault, err := c.vault(access.VaultID)
+	if err != nil {
+		return err
+	}
+	access.VaultID = vault.ID
+	i := c.groupAccessIndex(access.VaultID, access.AccessorID)
+	if i == -1 {
+		return util.NewError(util.ErrNotFound, fmt.Errorf("access of group %q to vault %q not found", access.AccessorID, access.VaultID))
+	}
+	c.data.Permissions[access.VaultID][i].Permissions = access.Permissions
+
+	return c.save()
+}
+
+func (c *Client) RevokeVaultGroupPermissions(_ context.Context, vaultUuid, groupUuid string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	vault, err := c.vault(vaultUuid)
+	if err != nil {
+		return err
+	}
+	i := c.groupAccessIndex(vault.ID, groupUuid)
+	if i == -1 {
+		return util.NewError(util.ErrNotFound, fmt.Errorf("access of group %q to vault %q not found", groupUuid, vaultUuid))
+	}
+	c.data.Permissions[vault.ID] = slices.Delete(c.data.Permissions[vault.ID], i, i+1)
+
+	return c.save()
+}
+
+// GetItem looks up an item by UUID or, like the SDK client, by title when itemUuid isn't a valid UUID.
+func (c *Client) GetItem(ctx context.Context, itemUuid, vaultUuid string) (*model.Item, error) {
+	if !util.IsValidUUID(itemUuid) {
+		return c.GetItemByTitle(ctx, itemUuid, vaultUuid)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	item, err := c.item(itemUuid, vaultUuid)
+	if err != nil {
+		return nil, err
+	}
+	return copyItem(item), nil
+}
+
+func (c *Client) GetItemByTitle(_ context.Context, title string, vaultUuid string) (*model.Item, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	vault, err := c.vault(vaultUuid)
+	if err != nil {
+		return nil, err
+	}
+
+	var matched []*model.Item
+	for _, item := range c.data.Items[vault.ID] {
+		if item.Title == title {
+			matched = append(matched, item)
+		}
+	}
+	if len(matched) != 1 {
+		return nil, fmt.Errorf("found %d item(s) in vault %q with title %q", len(matched), vaultUuid, title)
+	}
+	return copyItem(matched[0]), nil
+}
+
+func (c *Client) ListItems(_ context.Context, vaultUuid string) ([]model.ItemOverview, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	vault, err := c.vault(vaultUuid)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]model.ItemOverview, 0, len(c.data.Items[vault.ID]))
+	for _, item := range c.data.Items[vault.ID] {
+		overview := model.ItemOverview{
+			ID:        item.ID,
+			Title:     item.Title,
+			VaultID:   item.VaultID,
+			Category:  item.Category,
+			Tags:      slices.Clone(item.Tags),
+			URLs:      make([]string, len(item.URLs)),
+			CreatedAt: item.CreatedAt,
+			UpdatedAt: item.UpdatedAt,
+		}
+		for i, url := range item.URLs {
+			overview.URLs[i] = url.URL
+		}
+		result = append(result, overview)
+	}
+	slices.SortFunc(result, func(a, b model.ItemOverview) int {
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
+	return result, nil
+}
+
+func (c *Client) CreateItem(_ context.Context, item *model.Item, vaultUuid string) (*model.Item, error) {
+	if item.VaultID != vaultUuid {
+		return nil, fmt.Errorf("vault UUID mismatch: item has %s but %s was provided", item.VaultID, vaultUuid)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	vault, err := c.vault(vaultUuid)
+	if err != nil {
+		return nil, err
+	}
+
+	created := copyItem(item)
+	created.ID = newID()
+	created.VaultID = vault.ID
+	created.Version = 1
+	created.CreatedAt = time.Now().UTC()
+	created.UpdatedAt = created.CreatedAt
+	if err := prepareFields(created); err != nil {
+		return nil, err
+	}
+
+	// Only document items are created with a file, files in sections are uploaded separately
+	created.Files = nil
+	if item.Category == model.Document {
+		for _, file := range item.Files {
+			stored, err := c.storeFile(file, created.Sections)
+			if err != nil {
+				return nil, err
+			}
+			created.Files = append(created.Files, stored)
+		}
+	}
+
+	c.data.Items[vault.ID][created.ID] = created
+	if err := c.save(); err != nil {
+		return nil, err
+	}
+	return copyItem(created), nil
+}
+
+func (c *Client) UpdateItem(_ context.Context, item *model.Item, vaultUuid string) (*model.Item, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	current, err := c.item(item.ID, vaultUuid)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only overwrite the expected version of the item when one is given
+	if item.Version != 0 && current.Version != item.Version {
+		return nil, util.NewError(util.ErrConflict, fmt.Errorf("failed to update item: version conflict, item version is %d, expected %d", current.Version, item.Version))
+	}
+
+	updated := copyItem(item)
+	updated.ID = current.ID
+	updated.VaultID = current.VaultID
+	updated.Version = current.Version + 1
+	updated.Favorite = current.Favorite
+	updated.CreatedAt = current.CreatedAt
+	updated.UpdatedAt = time.Now().UTC()
+	if err := prepareFields(updated); err != nil {
+		return nil, err
+	}
+
+	// Files are only changed by UploadFile, DeleteFile and ReplaceDocument
+	updated.Files = current.Files
+
+	c.data.Items[current.VaultID][current.ID] = updated
+	if err := c.save(); err != nil {
+		return nil, err
+	}
+	return copyItem(updated), nil
+}
+
+func (c *Client) DeleteItem(_ context.Context, item *model.Item, vaultUuid string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	current, err := c.item(item.ID, vaultUuid)
+	if err != nil {
+		return err
+	}
+	c.deleteFileContents(current.Files)
+	delete(c.data.Items[current.VaultID], current.ID)
+
+	return c.save()
+}
+
+func (c *Client) GetFileContent(_ context.Context, file *model.ItemFile, itemUUID, vaultUuid string) ([]byte, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	item, err := c.item(itemUUID, vaultUuid)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.ContainsFunc(item.Files, func(f model.ItemFile) bool { return f.ID == file.ID }) {
+		return nil, util.NewError(util.ErrNotFound, fmt.Errorf("file %q not found in item %q", file.ID, itemUUID))
+	}
+	return slices.Clone(c.data.Files[file.ID]), nil
+}
+
+// UploadFile attaches a file to the section of the item given by file.SectionID.
+func (c *Client) UploadFile(_ context.Context, item *model.Item, file *model.ItemFile, vaultUuid string) (*model.Item, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	current, err := c.item(item.ID, vaultUuid)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.ContainsFunc(current.Sections, func(s model.ItemSection) bool { return s.ID == file.SectionID }) {
+		return nil, util.NewError(util.ErrNotFound, fmt.Errorf("section %q not found in item %q", file.SectionID, item.ID))
+	}
+
+	stored, err := c.storeFile(*file, current.Sections)
+	if err != nil {
+		return nil, err
+	}
+	current.Files = append(current.Files, stored)
+	touch(current)
+
+	if err := c.save(); err != nil {
+		return nil, err
+	}
+	return copyItem(current), nil
+}
+
+// DeleteFile removes a file from the section of an item.
+func (c *Client) DeleteFile(_ context.Context, item *model.Item, file *model.ItemFile, vaultUuid string) (*model.Item, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	current, err := c.item(item.ID, vaultUuid)
+	if err != nil {
+		return nil, err
+	}
+	i := slices.IndexFunc(current.Files, func(f model.ItemFile) bool {
+		return f.SectionID == file.SectionID && (f.FieldID == file.FieldID || f.ID == file.ID)
+	})
+	if i == -1 {
+		return nil, util.NewError(util.ErrNotFound, fmt.Errorf("file %q not found in item %q", file.Name, item.ID))
+	}
+	c.deleteFileContents(current.Files[i : i+1])
+	current.Files = slices.Delete(current.Files, i, i+1)
+	touch(current)
+
+	if err := c.save(); err != nil {
+		return nil, err
+	}
+	return copyItem(current), nil
+}
+
+// ReplaceDocument replaces the file of a document item.
+func (c *Client) ReplaceDocument(_ context.Context, item *model.Item, file *model.ItemFile, vaultUuid string) (*model.Item, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	current, err := c.item(item.ID, vaultUuid)
+	if err != nil {
+		return nil, err
+	}
+	if current.Category != model.Document {
+		return nil, fmt.Errorf("item %q is not a document", item.ID)
+	}
+
+	stored, err := c.storeFile(*file, nil)
+	if err != nil {
+		return nil, err
+	}
+	c.deleteFileContents(current.Files)
+	current.Files = []model.ItemFile{stored}
+	touch(current)
+
+	if err := c.save(); err != nil {
+		return nil, err
+	}
+	return copyItem(current), nil
+}
+
+// GetEnvironmentVariables returns the variables of an Environment in the data file.
+func (c *Client) GetEnvironmentVariables(_ context.Context, environmentID string) ([]model.EnvironmentVariable, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	variables, ok := c.data.Environments[environmentID]
+	if !ok {
+		return nil, util.NewError(util.ErrNotFound, fmt.Errorf("environment %q not found", environmentID))
+	}
+	return slices.Clone(variables), nil
+}
+
+// vault looks up a vault by UUID or by name.
+func (c *Client) vault(vaultQuery string) (*model.Vault, error) {
+	if vault, ok := c.data.Vaults[vaultQuery]; ok {
+		return vault, nil
+	}
+
+	var matched []*model.Vault
+	for _, vault := range c.data.Vaults {
+		if vault.Name == vaultQuery {
+			matched = append(matched, vault)
+		}
+	}
+	switch len(matched) {
+	case 0:
+		return nil, util.NewError(util.ErrNotFound, fmt.Errorf("vault %q not found", vaultQuery))
+	case 1:
+		return matched[0], nil
+	default:
+		return nil, fmt.Errorf("multiple vaults found with name %q", vaultQuery)
+	}
+}
+
+func (c *Client) vaultWithItemCount(vault *model.Vault) *model.Vault {
+	v := *vault
+	v.ItemCount = len(c.data.Items[vault.ID])
+	return &v
+}
+
+func (c *Client) item(itemUuid, vaultUuid string) (*model.Item, error) {
+	vault, err := c.vault(vaultUuid)
+	if err != nil {
+		return nil, err
+	}
+	item, ok := c.data.Items[vault.ID][itemUuid]
+	if !ok {
+		return nil, util.NewError(util.ErrNotFound, fmt.Errorf("item %q not found in vault %q", itemUuid, vaultUuid))
+	}
+	return item, nil
+}
+
+func (c *Client) groupAccessIndex(vaultUuid, groupUuid string) int {
+	return slices.IndexFunc(c.data.Permissions[vaultUuid], func(a model.VaultAccess) bool {
+		return a.AccessorType == model.VaultAccessorTypeGroup && a.AccessorID == groupUuid
+	})
+}
+
+// storeFile keeps the content of the file and returns the file as it is stored in the item.
+func (c *Client) storeFile(file model.ItemFile, sections []model.ItemSection) (model.ItemFile, error) {
+	content, err := file.Content()
+	if err != nil {
+		return model.ItemFile{}, err
+	}
+
+	stored := model.ItemFile{
+		ID:        newID(),
+		Name:      file.Name,
+		Size:      len(content),
+		SectionID: file.SectionID,
+		FieldID:   file.FieldID,
+	}
+	for _, s := range sections {
+		if s.ID == file.SectionID {
+			stored.SectionLabel = s.Label
+		}
+	}
+	c.data.Files[stored.ID] = slices.Clone(content)
+	return stored, nil
+}
+
+func (c *Client) deleteFileContents(files []model.ItemFile) {
+	for _, f := range files {
+		delete(c.data.Files, f.ID)
+	}
+}
+
+// prepareFields sets the IDs of new fields and generates the values of fields that should be generated.
+func prepareFields(item *model.Item) error {
+	sectionLabels := make(map[string]string, len(item.Sections))
+	for _, s := range item.Sections {
+		sectionLabels[s.ID] = s.Label
+	}
+
+	for i := range item.Fields {
+		f := &item.Fields[i]
+		if f.ID == "" {
+			f.ID = newID()
+		}
+		if f.SectionID != "" {
+			label, ok := sectionLabels[f.SectionID]
+			if !ok {
+				return fmt.Errorf("section %q of field %q not found in item", f.SectionID, f.Label)
+			}
+			f.SectionLabel = label
+		}
+		if f.Generate {
+			recipe := f.Recipe
+			if recipe == nil {
+				recipe = defaultRecipe
+			}
+			password, err := model.GeneratePassword(recipe)
+			if err != nil {
+				return fmt.Errorf("failed to generate value of field %q: %w", f.Label, err)
+			}
+			f.Value = password
+			f.Generate = false
+		}
+	}
+	return nil
+}
+
+func touch(item *model.Item) {
+	item.Version++
+	item.UpdatedAt = time.Now().UTC()
+}
+
+// copyItem returns a deep copy of the item, so callers can't change the stored item.
+func copyItem(item *model.Item) *model.Item {
+	c := *item
+	c.Tags = slices.Clone(item.Tags)
+	c.URLs = slices.Clone(item.URLs)
+	c.Sections = slices.Clone(item.Sections)
+	c.Fields = slices.Clone(item.Fields)
+	c.Files = slices.Clone(item.Files)
+	for i, f := range c.Fields {
+		if f.Recipe != nil {
+			recipe := *f.Recipe
+			recipe.CharacterSets = slices.Clone(f.Recipe.CharacterSets)
+			c.Fields[i].Recipe = &recipe
+		}
+	}
+	return &c
+}
+
+// newID returns an ID in the format 1Password uses, 26 lowercase letters and digits.
+func newID() string {
+	return strings.ToLower(rand.Text())
+}
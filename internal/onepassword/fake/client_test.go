@@ -0,0 +1,273 @@
+package fake
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/model"
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/util"
+)
+
+func newTestClient(t *testing.T) (*Client, *model.Vault) {
+	t.Helper()
+
+	client, err := NewClient(Config{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	vault, err := client.CreateVault(context.Background(), &model.Vault{Name: "Test Vault"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	return client, vault
+}
+
+func TestItemLifecycle(t *testing.T) {
+	ctx := context.Background()
+	client, vault := newTestClient(t)
+
+	created, err := client.CreateItem(ctx, &model.Item{
+		Title:    "Test Item",
+		VaultID:  vault.ID,
+		Category: model.Login,
+		Sections: []model.ItemSection{{ID: "s1", Label: "API"}},
+		Fields: []model.ItemField{
+			{Label: "username", Purpose: model.FieldPurposeUsername, Value: "admin"},
+			{Label: "password", Purpose: model.FieldPurposePassword, Generate: true, Recipe: &model.GeneratorRecipe{Length: 20}},
+			{Label: "token", Type: model.FieldTypeConcealed, Value: "secret", SectionID: "s1"},
+		},
+	}, vault.ID)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if !util.IsValidUUID(created.ID) || created.VaultID != vault.ID || created.Version != 1 {
+		t.Errorf("Unexpected item ID %q, vault ID %q or version %d", created.ID, created.VaultID, created.Version)
+	}
+	for _, f := range created.Fields {
+		if f.ID == "" {
+			t.Errorf("Expected ID for field %q", f.Label)
+		}
+	}
+	if password := created.Fields[1]; len(password.Value) != 20 || password.Generate {
+		t.Errorf("Expected generated password of length 20, got %q", password.Value)
+	}
+	if token := created.Fields[2]; token.SectionLabel != "API" {
+		t.Errorf("Expected field in section 'API', got %q", token.SectionLabel)
+	}
+
+	byTitle, err := client.GetItem(ctx, "Test Item", "Test Vault")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(byTitle, created) {
+		t.Errorf("Expected item looked up by title to match\ngot      %+v\nexpected %+v", byTitle, created)
+	}
+
+	created.Title = "Updated Item"
+	updated, err := client.UpdateItem(ctx, created, vault.ID)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if updated.Title != "Updated Item" || updated.Version != 2 || !updated.CreatedAt.Equal(created.CreatedAt) {
+		t.Errorf("Unexpected title %q, version %d or creation time of updated item", updated.Title, updated.Version)
+	}
+
+	items, err := client.ListItems(ctx, vault.ID)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(items) != 1 || items[0].Title != "Updated Item" {
+		t.Errorf("Expected the updated item to be listed, got %+v", items)
+	}
+
+	if err := client.DeleteItem(ctx, updated, vault.ID); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, err := client.GetItem(ctx, updated.ID, vault.ID); !errors.Is(err, util.ErrNotFound) {
+		t.Errorf("Expected not found error, got %v", err)
+	}
+}
+
+func TestUpdateItemVersionConflict(t *testing.T) {
+	ctx := context.Background()
+	client, vault := newTestClient(t)
+
+	created, err := client.CreateItem(ctx, &model.Item{Title: "Test Item", VaultID: vault.ID, Category: model.Password}, vault.ID)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, err := client.UpdateItem(ctx, created, vault.ID); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// created still has version 1 while the item is at version 2
+	created.Title = "Stale Update"
+	if _, err := client.UpdateItem(ctx, created, vault.ID); !errors.Is(err, util.ErrConflict) {
+		t.Errorf("Expected version conflict, got %v", err)
+	}
+
+	created.Version = 0
+	updated, err := client.UpdateItem(ctx, created, vault.ID)
+	if err != nil {
+		t.Fatalf("Expected update without version to succeed, got %v", err)
+	}
+	if updated.Version != 3 {
+		t.Errorf("Expected version 3, got %d", updated.Version)
+	}
+}
+
+func TestReturnedItemsAreCopies(t *testing.T) {
+	ctx := context.Background()
+	client, vault := newTestClient(t)
+
+	created, err := client.CreateItem(ctx, &model.Item{
+		Title:    "Test Item",
+		VaultID:  vault.ID,
+		Category: model.Password,
+		Fields:   []model.ItemField{{ID: "password", Label: "password", Purpose: model.FieldPurposePassword, Value: "secret"}},
+	}, vault.ID)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	created.Fields[0].Value = "changed"
+
+	item, err := client.GetItem(ctx, created.ID, vault.ID)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if item.Fields[0].Value != "secret" {
+		t.Errorf("Expected stored item to be unchanged, got %q", item.Fields[0].Value)
+	}
+}
+
+func TestFiles(t *testing.T) {
+	ctx := context.Background()
+	client, vault := newTestClient(t)
+
+	created, err := client.CreateItem(ctx, &model.Item{
+		Title:    "Test Item",
+		VaultID:  vault.ID,
+		Category: model.SecureNote,
+		Sections: []model.ItemSection{{ID: "s1", Label: "Files"}},
+	}, vault.ID)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	file := &model.ItemFile{Name: "config.json", SectionID: "s1", FieldID: "f1"}
+	file.SetContent([]byte(`{"key":"value"}`))
+	withFile, err := client.UploadFile(ctx, created, file, vault.ID)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(withFile.Files) != 1 || withFile.Files[0].SectionLabel != "Files" || withFile.Files[0].Size != 15 || withFile.Version != 2 {
+		t.Fatalf("Unexpected files %+v or version %d", withFile.Files, withFile.Version)
+	}
+
+	content, err := client.GetFileContent(ctx, &withFile.Files[0], created.ID, vault.ID)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if string(content) != `{"key":"value"}` {
+		t.Errorf("Unexpected file content %q", content)
+	}
+
+	// Updating the item keeps its files
+	if withFile, err = client.UpdateItem(ctx, withFile, vault.ID); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(withFile.Files) != 1 {
+		t.Fatalf("Expected file to be kept on update, got %+v", withFile.Files)
+	}
+
+	withoutFile, err := client.DeleteFile(ctx, withFile, &withFile.Files[0], vault.ID)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(withoutFile.Files) != 0 {
+		t.Errorf("Expected file to be deleted, got %+v", withoutFile.Files)
+	}
+	if _, err := client.GetFileContent(ctx, &withFile.Files[0], created.ID, vault.ID); err == nil {
+		t.Errorf("Expected error reading deleted file")
+	}
+}
+
+func TestVaultPermissions(t *testing.T) {
+	ctx := context.Background()
+	client, vault := newTestClient(t)
+
+	access := model.VaultAccess{VaultID: vault.ID, AccessorID: "group1", Permissions: 32}
+	if err := client.GrantVaultGroupPermissions(ctx, access); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := client.GrantVaultGroupPermissions(ctx, access); err == nil {
+		t.Errorf("Expected error granting access twice")
+	}
+
+	access.Permissions = 48
+	if err := client.UpdateVaultGroupPermissions(ctx, access); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	permissions, err := client.GetVaultPermissions(ctx, vault.ID)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := []model.VaultAccess{{VaultID: vault.ID, AccessorType: model.VaultAccessorTypeGroup, AccessorID: "group1", Permissions: 48}}
+	if !reflect.DeepEqual(permissions, expected) {
+		t.Errorf("Expected permissions %+v, got %+v", expected, permissions)
+	}
+
+	if err := client.RevokeVaultGroupPermissions(ctx, vault.ID, "group1"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := client.RevokeVaultGroupPermissions(ctx, vault.ID, "group1"); !errors.Is(err, util.ErrNotFound) {
+		t.Errorf("Expected not found error, got %v", err)
+	}
+}
+
+func TestDataFile(t *testing.T) {
+	ctx := context.Background()
+	dataFile := filepath.Join(t.TempDir(), "data.json")
+
+	err := os.WriteFile(dataFile, []byte(`{
+  "vaults": {"abcdefghijklmnopqrstuvwxyz": {"Name": "Seeded"}},
+  "environments": {"env1": [{"Name": "API_KEY", "Value": "secret"}]}
+}`), 0o600)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	client, err := NewClient(Config{DataFile: dataFile})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	variables, err := client.GetEnvironmentVariables(ctx, "env1")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(variables) != 1 || variables[0].Value != "secret" {
+		t.Errorf("Unexpected variables %+v", variables)
+	}
+	created, err := client.CreateItem(ctx, &model.Item{Title: "Test Item", VaultID: "abcdefghijklmnopqrstuvwxyz", Category: model.Password}, "abcdefghijklmnopqrstuvwxyz")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// A new client, like the one of the next provider run, sees the item
+	reloaded, err := NewClient(Config{DataFile: dataFile})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	item, err := reloaded.GetItem(ctx, created.ID, "Seeded")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if item.Title != "Test Item" || item.Version != 1 {
+		t.Errorf("Unexpected item %+v", item)
+	}
+}
@@ -0,0 +1,107 @@
+package fake
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/model"
+)
+
+// data is everything stored by the fake backend. It is also the format of the data file,
+// which can be written by hand to start with existing vaults, items and Environments.
+type data struct {
+	Vaults map[string]*model.Vault `json:"vaults"`
+	// Items holds the items of every vault by vault ID and item ID.
+	Items       map[string]map[string]*model.Item `json:"items"`
+	Permissions map[string][]model.VaultAccess    `json:"permissions"`
+	// Files holds the content of every file by file ID.
+	Files        map[string][]byte                      `json:"files"`
+	Environments map[string][]model.EnvironmentVariable `json:"environments"`
+}
+
+// loadData reads the data file. A missing file is created on the first change.
+func loadData(path string) (*data, error) {
+	d := &data{}
+	if path != "" {
+		content, err := os.ReadFile(path)
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("failed to read fake backend data file: %w", err)
+		}
+		if len(content) > 0 {
+			if err := json.Unmarshal(content, d); err != nil {
+				return nil, fmt.Errorf("failed to parse fake backend data file %s: %w", path, err)
+			}
+		}
+	}
+
+	if d.Vaults == nil {
+		d.Vaults = map[string]*model.Vault{}
+	}
+	if d.Items == nil {
+		d.Items = map[string]map[string]*model.Item{}
+	}
+	if d.Permissions == nil {
+		d.Permissions = map[string][]model.VaultAccess{}
+	}
+	if d.Files == nil {
+		d.Files = map[string][]byte{}
+	}
+	if d.Environments == nil {
+		d.Environments = map[string][]model.EnvironmentVariable{}
+	}
+
+	// Hand-written data files may leave out the IDs that are already given by the map keys
+	for id, vault := range d.Vaults {
+		vault.ID = id
+		if d.Items[id] == nil {
+			d.Items[id] = map[string]*model.Item{}
+		}
+	}
+	for vaultID, items := range d.Items {
+		if _, ok := d.Vaults[vaultID]; !ok {
+			return nil, fmt.Errorf("fake backend data file contains items of unknown vault %q", vaultID)
+		}
+		for id, item := range items {
+			item.ID = id
+			item.VaultID = vaultID
+			if item.Version == 0 {
+				item.Version = 1
+			}
+		}
+	}
+
+	return d, nil
+}
+
+// save writes the data file, if any. The file is replaced at once so it is never left half written.
+func (c *Client) save() error {
+	if c.dataFile == "" {
+		return nil
+	}
+
+	content, err := json.MarshalIndent(c.data, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode fake backend data: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(c.dataFile), filepath.Base(c.dataFile)+".*")
+	if err != nil {
+		return fmt.Errorf("failed to write fake backend data file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(content); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write fake backend data file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write fake backend data file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), c.dataFile); err != nil {
+		return fmt.Errorf("failed to write fake backend data file: %w", err)
+	}
+	return nil
+}
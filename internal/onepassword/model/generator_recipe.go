@@ -0,0 +1,276 @@
+package model
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+
+	connect "github.com/1Password/connect-sdk-go/onepassword"
+	sdk "github.com/1password/onepassword-sdk-go"
+)
+
+type GeneratorRecipeType string
+type PasswordSeparator string
+type PasswordWordList string
+
+const (
+	RecipeTypeRandom    GeneratorRecipeType = "RANDOM"
+	RecipeTypeMemorable GeneratorRecipeType = "MEMORABLE"
+	RecipeTypePin       GeneratorRecipeType = "PIN"
+
+	SeparatorHyphens          PasswordSeparator = "HYPHENS"
+	SeparatorSpaces           PasswordSeparator = "SPACES"
+	SeparatorPeriods          PasswordSeparator = "PERIODS"
+	SeparatorCommas           PasswordSeparator = "COMMAS"
+	SeparatorUnderscores      PasswordSeparator = "UNDERSCORES"
+	SeparatorDigits           PasswordSeparator = "DIGITS"
+	SeparatorDigitsAndSymbols PasswordSeparator = "DIGITS_AND_SYMBOLS"
+
+	WordListFullWords    PasswordWordList = "FULL_WORDS"
+	WordListSyllables    PasswordWordList = "SYLLABLES"
+	WordListThreeLetters PasswordWordList = "THREE_LETTERS"
+)
+
+var modelToSDKSeparatorMap = map[PasswordSeparator]sdk.SeparatorType{
+	SeparatorHyphens:          sdk.SeparatorTypeHyphens,
+	SeparatorSpaces:           sdk.SeparatorTypeSpaces,
+	SeparatorPeriods:          sdk.SeparatorTypePeriods,
+	SeparatorCommas:           sdk.SeparatorTypeCommas,
+	SeparatorUnderscores:      sdk.SeparatorTypeUnderscores,
+	SeparatorDigits:           sdk.SeparatorTypeDigits,
+	SeparatorDigitsAndSymbols: sdk.SeparatorTypeDigitsAndSymbols,
+}
+
+var modelToSDKWordListMap = map[PasswordWordList]sdk.WordListType{
+	WordListFullWords:    sdk.WordListTypeFullWords,
+	WordListSyllables:    sdk.WordListTypeSyllables,
+	WordListThreeLetters: sdk.WordListTypeThreeLetters,
+}
+
+// toSDKPasswordRecipe converts a recipe to the matching SDK recipe variant. Recipes without a type are random.
+func toSDKPasswordRecipe(recipe *GeneratorRecipe) sdk.PasswordRecipe {
+	switch recipe.Type {
+	case RecipeTypeMemorable:
+		separator, ok := modelToSDKSeparatorMap[recipe.Separator]
+		if !ok {
+			separator = sdk.SeparatorTypeHyphens
+		}
+		wordList, ok := modelToSDKWordListMap[recipe.WordList]
+		if !ok {
+			wordList = sdk.WordListTypeFullWords
+		}
+
+		return sdk.NewPasswordRecipeTypeVariantMemorable(&sdk.PasswordRecipeMemorableInner{
+			SeparatorType: separator,
+			Capitalize:    recipe.Capitalize,
+			WordListType:  wordList,
+			WordCount:     uint32(recipe.WordCount),
+		})
+	case RecipeTypePin:
+		return sdk.NewPasswordRecipeTypeVariantPin(&sdk.PasswordRecipePinInner{
+			Length: uint32(recipe.Length),
+		})
+	default:
+		includeDigits := false
+		includeSymbols := false
+
+		for _, characterSet := range recipe.CharacterSets {
+			switch characterSet {
+			case CharacterSetDigits:
+				includeDigits = true
+			case CharacterSetSymbols:
+				includeSymbols = true
+			}
+		}
+
+		return sdk.NewPasswordRecipeTypeVariantRandom(&sdk.PasswordRecipeRandomInner{
+			IncludeDigits:  includeDigits,
+			IncludeSymbols: includeSymbols,
+			Length:         uint32(recipe.Length),
+		})
+	}
+}
+
+// Character pools used when a random password is generated locally.
+// The symbols match the ones used by the 1Password password generator.
+const (
+	recipeLetters = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	recipeDigits  = "0123456789"
+	recipeSymbols = "!@.-_*"
+)
+
+// sdkSupportsRecipe reports whether the SDK password generator can produce a password for the recipe.
+func sdkSupportsRecipe(recipe *GeneratorRecipe) bool {
+	if recipe.Type == RecipeTypeMemorable || recipe.Type == RecipeTypePin {
+		return true
+	}
+	return !recipe.ExcludeLetters && recipe.ExcludeCharacters == "" && recipe.MinDigits == 0 && recipe.MinSymbols == 0
+}
+
+// connectSupportsRecipe reports whether the recipe can be expressed as a Connect recipe.
+func connectSupportsRecipe(recipe *GeneratorRecipe) bool {
+	if recipe.Type == RecipeTypeMemorable {
+		return false
+	}
+	return recipe.MinDigits == 0 && recipe.MinSymbols == 0
+}
+
+// AllowedCharacters returns the letters, digits and symbols a random recipe can use once the excluded characters are removed.
+// A set is empty when the recipe doesn't use it.
+func (r *GeneratorRecipe) AllowedCharacters() (letters, digits, symbols string) {
+	if !r.ExcludeLetters {
+		letters = removeCharacters(recipeLetters, r.ExcludeCharacters)
+	}
+	for _, characterSet := range r.CharacterSets {
+		switch characterSet {
+		case CharacterSetDigits:
+			digits = removeCharacters(recipeDigits, r.ExcludeCharacters)
+		case CharacterSetSymbols:
+			symbols = removeCharacters(recipeSymbols, r.ExcludeCharacters)
+		}
+	}
+	return letters, digits, symbols
+}
+
+// ValidateRecipe checks that a password can be generated for the recipe.
+// Random recipes must keep at least one character of every enabled set and be long enough to use every set.
+func ValidateRecipe(recipe *GeneratorRecipe) error {
+	if recipe.Type == RecipeTypeMemorable || recipe.Type == RecipeTypePin {
+		return nil
+	}
+
+	letters, digits, symbols := recipe.AllowedCharacters()
+	if letters+digits+symbols == "" {
+		return fmt.Errorf("password recipe doesn't allow any characters")
+	}
+	if !recipe.ExcludeLetters && letters == "" {
+		return fmt.Errorf("password recipe excludes every letter")
+	}
+	for _, set := range []struct {
+		name       string
+		enabled    CharacterSet
+		characters string
+	}{{"digit", CharacterSetDigits, digits}, {"symbol", CharacterSetSymbols, symbols}} {
+		if set.characters == "" && slices.Contains(recipe.CharacterSets, set.enabled) {
+			return fmt.Errorf("password recipe excludes every %s", set.name)
+		}
+	}
+
+	if required := len(requiredCharacters(recipe, letters, digits, symbols)); required > recipe.Length {
+		return fmt.Errorf("password recipe requires %d characters but the length is %d", required, recipe.Length)
+	}
+	return nil
+}
+
+// requiredCharacters returns a character pool for every character the password must contain:
+// one letter, and at least one or MinDigits and MinSymbols of the enabled digits and symbols.
+func requiredCharacters(recipe *GeneratorRecipe, letters, digits, symbols string) []string {
+	var required []string
+	if letters != "" {
+		required = append(required, letters)
+	}
+	for _, set := range []struct {
+		characters string
+		min        int
+	}{{digits, recipe.MinDigits}, {symbols, recipe.MinSymbols}} {
+		if set.characters == "" {
+			continue
+		}
+		for i := 0; i < max(set.min, 1); i++ {
+			required = append(required, set.characters)
+		}
+	}
+	return required
+}
+
+// generateRandomPassword generates a random password locally for recipes the SDK generator can't express.
+// Every enabled character set is used at least once, digits and symbols at least MinDigits and MinSymbols times.
+func generateRandomPassword(recipe *GeneratorRecipe) (string, error) {
+	letters, digits, symbols := recipe.AllowedCharacters()
+
+	pool := letters + digits + symbols
+	if pool == "" {
+		return "", fmt.Errorf("password recipe doesn't allow any characters")
+	}
+	if recipe.MinDigits > 0 && digits == "" {
+		return "", fmt.Errorf("password recipe requires %d digits but doesn't allow any", recipe.MinDigits)
+	}
+	if recipe.MinSymbols > 0 && symbols == "" {
+		return "", fmt.Errorf("password recipe requires %d symbols but doesn't allow any", recipe.MinSymbols)
+	}
+
+	required := requiredCharacters(recipe, letters, digits, symbols)
+	if len(required) > recipe.Length {
+		return "", fmt.Errorf("password recipe requires %d characters but the length is %d", len(required), recipe.Length)
+	}
+
+	password := make([]byte, recipe.Length)
+	for i := range password {
+		characters := pool
+		if i < len(required) {
+			characters = required[i]
+		}
+		c, err := randomCharacter(characters)
+		if err != nil {
+			return "", err
+		}
+		password[i] = c
+	}
+
+	// Shuffle so the required characters aren't always at the start of the password.
+	for i := len(password) - 1; i > 0; i-- {
+		j, err := rand.Int(rand.Reader, big.NewInt(int64(i+1)))
+		if err != nil {
+			return "", err
+		}
+		password[i], password[j.Int64()] = password[j.Int64()], password[i]
+	}
+
+	return string(password), nil
+}
+
+func randomCharacter(characters string) (byte, error) {
+	i, err := rand.Int(rand.Reader, big.NewInt(int64(len(characters))))
+	if err != nil {
+		return 0, err
+	}
+	return characters[i.Int64()], nil
+}
+
+func removeCharacters(characters, exclude string) string {
+	return strings.Map(func(r rune) rune {
+		if strings.ContainsRune(exclude, r) {
+			return -1
+		}
+		return r
+	}, characters)
+}
+
+// toConnectRecipe converts a random or PIN recipe to a Connect recipe.
+// Memorable recipes and minimum character counts can't be expressed in Connect and must be generated locally.
+func toConnectRecipe(recipe *GeneratorRecipe) *connect.GeneratorRecipe {
+	if recipe.Type == RecipeTypePin {
+		return &connect.GeneratorRecipe{
+			Length:        recipe.Length,
+			CharacterSets: []string{string(CharacterSetDigits)},
+		}
+	}
+
+	// Connect only uses letters when they are listed in the character sets
+	characterSets := []string{}
+	if !recipe.ExcludeLetters {
+		characterSets = append(characterSets, "LETTERS")
+	}
+
+	for _, cs := range recipe.CharacterSets {
+		characterSets = append(characterSets, string(cs))
+	}
+
+	return &connect.GeneratorRecipe{
+		Length:            recipe.Length,
+		CharacterSets:     characterSets,
+		ExcludeCharacters: recipe.ExcludeCharacters,
+	}
+}
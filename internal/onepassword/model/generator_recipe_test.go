@@ -0,0 +1,350 @@
+package model
+
+import (
+	"reflect"
+	"regexp"
+	"testing"
+
+	connect "github.com/1Password/connect-sdk-go/onepassword"
+	sdk "github.com/1password/onepassword-sdk-go"
+)
+
+func TestToSDKPasswordRecipe(t *testing.T) {
+	tests := map[string]struct {
+		input    *GeneratorRecipe
+		expected sdk.PasswordRecipe
+	}{
+		"should default to random recipe": {
+			input: &GeneratorRecipe{
+				Length:        20,
+				CharacterSets: []CharacterSet{CharacterSetDigits},
+			},
+			expected: sdk.NewPasswordRecipeTypeVariantRandom(&sdk.PasswordRecipeRandomInner{
+				IncludeDigits: true,
+				Length:        20,
+			}),
+		},
+		"should convert random recipe": {
+			input: &GeneratorRecipe{
+				Type:          RecipeTypeRandom,
+				Length:        32,
+				CharacterSets: []CharacterSet{CharacterSetDigits, CharacterSetSymbols},
+			},
+			expected: sdk.NewPasswordRecipeTypeVariantRandom(&sdk.PasswordRecipeRandomInner{
+				IncludeDigits:  true,
+				IncludeSymbols: true,
+				Length:         32,
+			}),
+		},
+		"should convert PIN recipe": {
+			input: &GeneratorRecipe{
+				Type:   RecipeTypePin,
+				Length: 6,
+			},
+			expected: sdk.NewPasswordRecipeTypeVariantPin(&sdk.PasswordRecipePinInner{
+				Length: 6,
+			}),
+		},
+		"should convert memorable recipe": {
+			input: &GeneratorRecipe{
+				Type:       RecipeTypeMemorable,
+				WordCount:  5,
+				Separator:  SeparatorUnderscores,
+				Capitalize: true,
+				WordList:   WordListSyllables,
+			},
+			expected: sdk.NewPasswordRecipeTypeVariantMemorable(&sdk.PasswordRecipeMemorableInner{
+				SeparatorType: sdk.SeparatorTypeUnderscores,
+				Capitalize:    true,
+				WordListType:  sdk.WordListTypeSyllables,
+				WordCount:     5,
+			}),
+		},
+		"should default memorable separator and word list": {
+			input: &GeneratorRecipe{
+				Type:      RecipeTypeMemorable,
+				WordCount: 4,
+			},
+			expected: sdk.NewPasswordRecipeTypeVariantMemorable(&sdk.PasswordRecipeMemorableInner{
+				SeparatorType: sdk.SeparatorTypeHyphens,
+				WordListType:  sdk.WordListTypeFullWords,
+				WordCount:     4,
+			}),
+		},
+	}
+
+	for description, test := range tests {
+		t.Run(description, func(t *testing.T) {
+			actual := toSDKPasswordRecipe(test.input)
+			if actual.Type != test.expected.Type {
+				t.Fatalf("Type: got %v, expected %v", actual.Type, test.expected.Type)
+			}
+			switch actual.Type {
+			case sdk.PasswordRecipeTypeVariantRandom:
+				if *actual.Random() != *test.expected.Random() {
+					t.Errorf("Random: got %+v, expected %+v", *actual.Random(), *test.expected.Random())
+				}
+			case sdk.PasswordRecipeTypeVariantPin:
+				if *actual.Pin() != *test.expected.Pin() {
+					t.Errorf("Pin: got %+v, expected %+v", *actual.Pin(), *test.expected.Pin())
+				}
+			case sdk.PasswordRecipeTypeVariantMemorable:
+				if *actual.Memorable() != *test.expected.Memorable() {
+					t.Errorf("Memorable: got %+v, expected %+v", *actual.Memorable(), *test.expected.Memorable())
+				}
+			}
+		})
+	}
+}
+
+func TestGeneratePassword(t *testing.T) {
+	tests := map[string]struct {
+		input   *GeneratorRecipe
+		pattern string
+	}{
+		"should generate PIN": {
+			input: &GeneratorRecipe{
+				Type:   RecipeTypePin,
+				Length: 6,
+			},
+			pattern: "^[0-9]{6}$",
+		},
+		"should generate random password without excluded characters": {
+			input: &GeneratorRecipe{
+				Length:            64,
+				CharacterSets:     []CharacterSet{CharacterSetDigits, CharacterSetSymbols},
+				ExcludeCharacters: "aeiou0*@",
+			},
+			pattern: "^[^aeiou0*@]{64}$",
+		},
+		"should generate random password without letters": {
+			input: &GeneratorRecipe{
+				Length:         16,
+				CharacterSets:  []CharacterSet{CharacterSetDigits},
+				ExcludeLetters: true,
+			},
+			pattern: "^[0-9]{16}$",
+		},
+		"should generate memorable password": {
+			input: &GeneratorRecipe{
+				Type:      RecipeTypeMemorable,
+				WordCount: 3,
+				Separator: SeparatorPeriods,
+				WordList:  WordListThreeLetters,
+			},
+			pattern: `^[a-z]{3}\.[a-z]{3}\.[a-z]{3}$`,
+		},
+	}
+
+	for description, test := range tests {
+		t.Run(description, func(t *testing.T) {
+			actual, err := GeneratePassword(test.input)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !regexp.MustCompile(test.pattern).MatchString(actual) {
+				t.Errorf("Password %q doesn't match %s", actual, test.pattern)
+			}
+		})
+	}
+}
+
+func TestGenerateRandomPasswordMinimumCounts(t *testing.T) {
+	recipe := &GeneratorRecipe{
+		Length:        8,
+		CharacterSets: []CharacterSet{CharacterSetDigits, CharacterSetSymbols},
+		MinDigits:     3,
+		MinSymbols:    3,
+	}
+
+	for i := 0; i < 20; i++ {
+		actual, err := generateRandomPassword(recipe)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(actual) != recipe.Length {
+			t.Errorf("Length of %q: got %d, expected %d", actual, len(actual), recipe.Length)
+		}
+		if digits := len(regexp.MustCompile("[0-9]").FindAllString(actual, -1)); digits < recipe.MinDigits {
+			t.Errorf("Digits in %q: got %d, expected at least %d", actual, digits, recipe.MinDigits)
+		}
+		if symbols := len(regexp.MustCompile("[^a-zA-Z0-9]").FindAllString(actual, -1)); symbols < recipe.MinSymbols {
+			t.Errorf("Symbols in %q: got %d, expected at least %d", actual, symbols, recipe.MinSymbols)
+		}
+	}
+}
+
+func TestGenerateRandomPasswordErrors(t *testing.T) {
+	tests := map[string]*GeneratorRecipe{
+		"no characters allowed": {
+			Length:         8,
+			ExcludeLetters: true,
+		},
+		"all digits excluded": {
+			Length:            8,
+			CharacterSets:     []CharacterSet{CharacterSetDigits},
+			ExcludeCharacters: "0123456789",
+			MinDigits:         1,
+		},
+		"minimum counts longer than length": {
+			Length:        4,
+			CharacterSets: []CharacterSet{CharacterSetDigits, CharacterSetSymbols},
+			MinDigits:     3,
+			MinSymbols:    3,
+		},
+	}
+
+	for description, recipe := range tests {
+		t.Run(description, func(t *testing.T) {
+			if _, err := generateRandomPassword(recipe); err == nil {
+				t.Errorf("Expected error but got none")
+			}
+		})
+	}
+}
+
+func TestValidateRecipe(t *testing.T) {
+	tests := map[string]struct {
+		recipe      *GeneratorRecipe
+		expectedErr bool
+	}{
+		"should accept random recipe": {
+			recipe: &GeneratorRecipe{
+				Length:            8,
+				CharacterSets:     []CharacterSet{CharacterSetDigits, CharacterSetSymbols},
+				ExcludeCharacters: "0O",
+				MinDigits:         2,
+			},
+		},
+		"should accept recipe using every set once": {
+			recipe: &GeneratorRecipe{
+				Length:        3,
+				CharacterSets: []CharacterSet{CharacterSetDigits, CharacterSetSymbols},
+			},
+		},
+		"should ignore memorable recipe": {
+			recipe: &GeneratorRecipe{
+				Type:      RecipeTypeMemorable,
+				WordCount: 4,
+			},
+		},
+		"should reject length below the number of required sets": {
+			recipe: &GeneratorRecipe{
+				Length:        2,
+				CharacterSets: []CharacterSet{CharacterSetDigits, CharacterSetSymbols},
+			},
+			expectedErr: true,
+		},
+		"should reject minimum counts longer than length": {
+			recipe: &GeneratorRecipe{
+				Length:        8,
+				CharacterSets: []CharacterSet{CharacterSetDigits, CharacterSetSymbols},
+				MinDigits:     4,
+				MinSymbols:    4,
+			},
+			expectedErr: true,
+		},
+		"should reject all digits excluded": {
+			recipe: &GeneratorRecipe{
+				Length:            8,
+				CharacterSets:     []CharacterSet{CharacterSetDigits},
+				ExcludeCharacters: "0123456789",
+			},
+			expectedErr: true,
+		},
+		"should reject all symbols excluded": {
+			recipe: &GeneratorRecipe{
+				Length:            8,
+				CharacterSets:     []CharacterSet{CharacterSetSymbols},
+				ExcludeCharacters: "!@.-_*",
+			},
+			expectedErr: true,
+		},
+		"should reject no characters allowed": {
+			recipe: &GeneratorRecipe{
+				Length:         8,
+				ExcludeLetters: true,
+			},
+			expectedErr: true,
+		},
+	}
+
+	for description, test := range tests {
+		t.Run(description, func(t *testing.T) {
+			err := ValidateRecipe(test.recipe)
+			if (err != nil) != test.expectedErr {
+				t.Errorf("Expected error %v, got %v", test.expectedErr, err)
+			}
+		})
+	}
+}
+
+func TestToConnectRecipe(t *testing.T) {
+	tests := map[string]struct {
+		input    *GeneratorRecipe
+		expected *connect.GeneratorRecipe
+	}{
+		"should include letters by default": {
+			input: &GeneratorRecipe{
+				Length:        20,
+				CharacterSets: []CharacterSet{CharacterSetDigits},
+			},
+			expected: &connect.GeneratorRecipe{
+				Length:        20,
+				CharacterSets: []string{"LETTERS", "DIGITS"},
+			},
+		},
+		"should exclude letters and characters": {
+			input: &GeneratorRecipe{
+				Length:            20,
+				CharacterSets:     []CharacterSet{CharacterSetDigits, CharacterSetSymbols},
+				ExcludeLetters:    true,
+				ExcludeCharacters: "$\\\"'",
+			},
+			expected: &connect.GeneratorRecipe{
+				Length:            20,
+				CharacterSets:     []string{"DIGITS", "SYMBOLS"},
+				ExcludeCharacters: "$\\\"'",
+			},
+		},
+	}
+
+	for description, test := range tests {
+		t.Run(description, func(t *testing.T) {
+			actual := toConnectRecipe(test.input)
+			if !reflect.DeepEqual(actual, test.expected) {
+				t.Errorf("toConnectRecipe() = %+v, expected %+v", actual, test.expected)
+			}
+		})
+	}
+}
+
+func TestToConnectFieldsGeneratesMemorablePassword(t *testing.T) {
+	actual, err := toConnectFields([]ItemField{
+		{
+			ID:       "password",
+			Type:     FieldTypeConcealed,
+			Generate: true,
+			Recipe: &GeneratorRecipe{
+				Type:      RecipeTypeMemorable,
+				WordCount: 4,
+				Separator: SeparatorHyphens,
+				WordList:  WordListFullWords,
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	field := actual[0]
+	if field.Generate {
+		t.Errorf("Generate: got true, expected false")
+	}
+	if field.Recipe != nil {
+		t.Errorf("Recipe: got %+v, expected nil", field.Recipe)
+	}
+	if !regexp.MustCompile("^[a-z]+(-[a-z]+){3}$").MatchString(field.Value) {
+		t.Errorf("Value %q is not a memorable password with 4 words", field.Value)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	connect "github.com/1Password/connect-sdk-go/onepassword"
 	sdk "github.com/1password/onepassword-sdk-go"
@@ -11,48 +12,88 @@ import (
 	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/util"
 )
 
+type AutofillBehavior string
 type CharacterSet string
 type ItemCategory string
 type ItemFieldPurpose string
 type ItemFieldType string
 
 const (
+	AutofillBehaviorAnywhereOnWebsite AutofillBehavior = "ANYWHERE_ON_WEBSITE"
+	AutofillBehaviorExactDomain       AutofillBehavior = "EXACT_DOMAIN"
+	AutofillBehaviorNever             AutofillBehavior = "NEVER"
+
 	CharacterSetDigits  CharacterSet = "DIGITS"
 	CharacterSetSymbols CharacterSet = "SYMBOLS"
 
-	Login         ItemCategory = "LOGIN"
-	Password      ItemCategory = "PASSWORD"
-	SecureNote    ItemCategory = "SECURE_NOTE"
-	Document      ItemCategory = "DOCUMENT"
-	SSHKey        ItemCategory = "SSH_KEY"
-	Database      ItemCategory = "DATABASE"
-	APICredential ItemCategory = "API_CREDENTIAL"
+	Login                ItemCategory = "LOGIN"
+	Password             ItemCategory = "PASSWORD"
+	SecureNote           ItemCategory = "SECURE_NOTE"
+	Document             ItemCategory = "DOCUMENT"
+	SSHKey               ItemCategory = "SSH_KEY"
+	Database             ItemCategory = "DATABASE"
+	APICredential        ItemCategory = "API_CREDENTIAL"
+	BankAccount          ItemCategory = "BANK_ACCOUNT"
+	CreditCard           ItemCategory = "CREDIT_CARD"
+	CryptoWallet         ItemCategory = "CRYPTO_WALLET"
+	DriverLicense        ItemCategory = "DRIVER_LICENSE"
+	EmailAccount         ItemCategory = "EMAIL_ACCOUNT"
+	Identity             ItemCategory = "IDENTITY"
+	MedicalRecord        ItemCategory = "MEDICAL_RECORD"
+	Membership           ItemCategory = "MEMBERSHIP"
+	OutdoorLicense       ItemCategory = "OUTDOOR_LICENSE"
+	Passport             ItemCategory = "PASSPORT"
+	Person               ItemCategory = "PERSON"
+	RewardProgram        ItemCategory = "REWARD_PROGRAM"
+	Server               ItemCategory = "SERVER"
+	SocialSecurityNumber ItemCategory = "SOCIAL_SECURITY_NUMBER"
+	SoftwareLicense      ItemCategory = "SOFTWARE_LICENSE"
+	WirelessRouter       ItemCategory = "WIRELESS_ROUTER"
 
 	FieldPurposeUsername ItemFieldPurpose = "USERNAME"
 	FieldPurposePassword ItemFieldPurpose = "PASSWORD"
 	FieldPurposeNotes    ItemFieldPurpose = "NOTES"
 
-	FieldTypeConcealed ItemFieldType = "CONCEALED"
-	FieldTypeDate      ItemFieldType = "DATE"
-	FieldTypeEmail     ItemFieldType = "EMAIL"
-	FieldTypeMenu      ItemFieldType = "MENU"
-	FieldTypeMonthYear ItemFieldType = "MONTH_YEAR"
-	FieldTypeOTP       ItemFieldType = "OTP"
-	FieldTypeString    ItemFieldType = "STRING"
-	FieldTypeURL       ItemFieldType = "URL"
+	FieldTypeConcealed        ItemFieldType = "CONCEALED"
+	FieldTypeCreditCardNumber ItemFieldType = "CREDIT_CARD_NUMBER"
+	FieldTypeCreditCardType   ItemFieldType = "CREDIT_CARD_TYPE"
+	FieldTypeDate             ItemFieldType = "DATE"
+	FieldTypeEmail            ItemFieldType = "EMAIL"
+	FieldTypeMenu             ItemFieldType = "MENU"
+	FieldTypeMonthYear        ItemFieldType = "MONTH_YEAR"
+	FieldTypeOTP              ItemFieldType = "OTP"
+	FieldTypePhone            ItemFieldType = "PHONE"
+	FieldTypeReference        ItemFieldType = "REFERENCE"
+	FieldTypeSSHKey           ItemFieldType = "SSH_KEY"
+	FieldTypeString           ItemFieldType = "STRING"
+	FieldTypeURL              ItemFieldType = "URL"
 )
 
+// Item is a 1Password item. Favorite and LastEditedBy are only set when using 1Password Connect.
 type Item struct {
-	ID       string
-	Title    string
-	VaultID  string
-	Category ItemCategory
-	Version  int
-	Tags     []string
-	URLs     []ItemURL
-	Sections []ItemSection
-	Fields   []ItemField
-	Files    []ItemFile
+	ID           string
+	Title        string
+	VaultID      string
+	Category     ItemCategory
+	Version      int
+	Favorite     bool
+	LastEditedBy string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	Tags         []string
+	URLs         []ItemURL
+	Sections     []ItemSection
+	Fields       []ItemField
+	Files        []ItemFile
+
+	// unsupportedFields are the labels of the fields whose type can't be converted back to 1Password.
+	unsupportedFields []string
+}
+
+// UnsupportedFields returns the labels of the fields whose type can't be converted back to 1Password,
+// such as addresses. Writing the item back would corrupt these fields.
+func (i *Item) UnsupportedFields() []string {
+	return i.unsupportedFields
 }
 
 type ItemSection struct {
@@ -72,15 +113,29 @@ type ItemField struct {
 	Generate     bool
 }
 
+// GeneratorRecipe describes how a password is generated.
+// Length, CharacterSets, ExcludeLetters, ExcludeCharacters, MinDigits and MinSymbols apply to random passwords,
+// Length to PINs and WordCount, Separator, Capitalize and WordList to memorable passwords.
 type GeneratorRecipe struct {
-	Length        int
-	CharacterSets []CharacterSet
-}
-
+	Type              GeneratorRecipeType
+	Length            int
+	CharacterSets     []CharacterSet
+	ExcludeLetters    bool
+	ExcludeCharacters string
+	MinDigits         int
+	MinSymbols        int
+	WordCount         int
+	Separator         PasswordSeparator
+	Capitalize        bool
+	WordList          PasswordWordList
+}
+
+// ItemURL is a website of an item. AutofillBehavior is only stored when using the 1Password SDK.
 type ItemURL struct {
-	URL     string
-	Label   string
-	Primary bool
+	URL              string
+	Label            string
+	Primary          bool
+	AutofillBehavior AutofillBehavior
 }
 
 // FromSDKItemToModel creates a new Item from an SDK item
@@ -92,6 +147,9 @@ func (i *Item) FromSDKItemToModel(item *sdk.Item) error {
 	i.Title = item.Title
 	i.VaultID = item.VaultID
 	i.Category = fromSDKCategoryToModel(item.Category)
+	i.Version = int(item.Version)
+	i.CreatedAt = item.CreatedAt
+	i.UpdatedAt = item.UpdatedAt
 	i.Tags = item.Tags
 	i.URLs = fromSDKURLs(item.Websites)
 
@@ -100,6 +158,12 @@ func (i *Item) FromSDKItemToModel(item *sdk.Item) error {
 	i.Sections = fromSDKSections(sectionMap)
 	i.Files = fromSDKFiles(item, sectionMap)
 	i.Fields = fromSDKFields(item, sectionMap)
+	i.unsupportedFields = nil
+	for _, f := range item.Fields {
+		if _, ok := sdkToModelFieldTypeMap[f.FieldType]; !ok {
+			i.unsupportedFields = append(i.unsupportedFields, f.Title)
+		}
+	}
 
 	// Notes are stored top level in an item from the SDK
 	if item.Notes != "" {
@@ -114,7 +178,7 @@ func (i *Item) FromSDKItemToModel(item *sdk.Item) error {
 }
 
 // FromModelItemToSDKCreateParams creates an SDK item create params from an Item
-func (i *Item) FromModelItemToSDKCreateParams() sdk.ItemCreateParams {
+func (i *Item) FromModelItemToSDKCreateParams() (sdk.ItemCreateParams, error) {
 	params := sdk.ItemCreateParams{
 		VaultID:  i.VaultID,
 		Title:    i.Title,
@@ -124,9 +188,31 @@ func (i *Item) FromModelItemToSDKCreateParams() sdk.ItemCreateParams {
 		Websites: toSDKWebsites(i.URLs),
 	}
 
-	params.Fields, params.Notes = toSDKFields(i.Fields)
+	var err error
+	params.Fields, params.Notes, err = toSDKFields(i.Fields)
+	if err != nil {
+		return params, err
+	}
+
+	if i.Category == Document {
+		params.Document = toSDKDocument(i.Files)
+	}
 
-	return params
+	return params, nil
+}
+
+// toSDKDocument returns the upload parameters of the first file outside a section that has its content loaded.
+func toSDKDocument(files []ItemFile) *sdk.DocumentCreateParams {
+	for _, f := range files {
+		if f.SectionID != "" || f.content == nil {
+			continue
+		}
+		return &sdk.DocumentCreateParams{
+			Name:    f.Name,
+			Content: f.content,
+		}
+	}
+	return nil
 }
 
 // FromConnectItemToModel creates a new Item from a Connect SDK item
@@ -140,6 +226,10 @@ func (i *Item) FromConnectItemToModel(item *connect.Item) error {
 	i.VaultID = item.Vault.ID
 	i.Category = ItemCategory(item.Category)
 	i.Version = item.Version
+	i.Favorite = item.Favorite
+	i.LastEditedBy = item.LastEditedBy
+	i.CreatedAt = item.CreatedAt
+	i.UpdatedAt = item.UpdatedAt
 	i.Tags = item.Tags
 	i.URLs = fromConnectURLs(item.URLs)
 
@@ -182,9 +272,10 @@ func fromSDKURLs(websites []sdk.Website) []ItemURL {
 	urls := make([]ItemURL, 0, len(websites))
 	for idx, w := range websites {
 		urls = append(urls, ItemURL{
-			URL:     w.URL,
-			Label:   w.Label,
-			Primary: idx == 0,
+			URL:              w.URL,
+			Label:            w.Label,
+			Primary:          idx == 0,
+			AutofillBehavior: sdkToModelAutofillBehaviorMap[w.AutofillBehavior],
 		})
 	}
 	return urls
@@ -260,9 +351,10 @@ func fromSDKFiles(item *sdk.Item, sectionMap map[string]ItemSection) []ItemFile
 
 	for _, f := range item.Files {
 		file := ItemFile{
-			ID:   f.Attributes.ID,
-			Name: f.Attributes.Name,
-			Size: int(f.Attributes.Size),
+			ID:      f.Attributes.ID,
+			Name:    f.Attributes.Name,
+			Size:    int(f.Attributes.Size),
+			FieldID: f.FieldID,
 		}
 
 		// Look up section by ID
@@ -288,7 +380,7 @@ func fromSDKFiles(item *sdk.Item, sectionMap map[string]ItemSection) []ItemFile
 	return files
 }
 
-func toSDKFields(fields []ItemField) ([]sdk.ItemField, *string) {
+func toSDKFields(fields []ItemField) ([]sdk.ItemField, *string, error) {
 	var notes *string
 	sdkFields := make([]sdk.ItemField, 0, len(fields))
 
@@ -297,22 +389,25 @@ func toSDKFields(fields []ItemField) ([]sdk.ItemField, *string) {
 			notes = &field.Value
 			continue
 		}
-		sdkFields = append(sdkFields, toSDKField(field))
+		sdkField, err := toSDKField(field)
+		if err != nil {
+			return sdkFields, notes, err
+		}
+		sdkFields = append(sdkFields, sdkField)
 	}
 
-	return sdkFields, notes
+	return sdkFields, notes, nil
 }
 
-func toSDKField(f ItemField) sdk.ItemField {
+func toSDKField(f ItemField) (sdk.ItemField, error) {
 	fieldID := f.ID
 
 	if f.Generate && f.Recipe != nil {
-		password, err := generatePassword(f.Recipe)
-		if err == nil {
-			f.Value = password
-		} else {
-			fmt.Printf("Error generating password: %v\n", err)
+		password, err := GeneratePassword(f.Recipe)
+		if err != nil {
+			return sdk.ItemField{}, fmt.Errorf("toSDKField: failed to generate password: %w", err)
 		}
+		f.Value = password
 	}
 
 	// Convert month-year from YYYYMM to MM/YYYY format for SDK
@@ -333,7 +428,7 @@ func toSDKField(f ItemField) sdk.ItemField {
 		field.SectionID = &f.SectionID
 	}
 
-	return field
+	return field, nil
 }
 
 func toSDKSections(sections []ItemSection) []sdk.ItemSection {
@@ -347,40 +442,36 @@ func toSDKSections(sections []ItemSection) []sdk.ItemSection {
 	return sdkSections
 }
 
+// toSDKWebsites converts the URLs to SDK websites. The SDK treats the first website as the primary one.
 func toSDKWebsites(urls []ItemURL) []sdk.Website {
 	websites := make([]sdk.Website, 0, len(urls))
 	for _, url := range urls {
-		if url.URL != "" {
-			websites = append(websites, sdk.Website{
-				URL:   url.URL,
-				Label: url.Label,
-			})
+		if url.URL == "" {
+			continue
+		}
+
+		website := sdk.Website{
+			URL:              url.URL,
+			Label:            url.Label,
+			AutofillBehavior: modelToSDKAutofillBehaviorMap[url.AutofillBehavior],
+		}
+
+		if url.Primary {
+			websites = append([]sdk.Website{website}, websites...)
+		} else {
+			websites = append(websites, website)
 		}
 	}
 	return websites
 }
 
-func generatePassword(recipe *GeneratorRecipe) (string, error) {
-	includeDigits := false
-	includeSymbols := false
-
-	for _, characterSet := range recipe.CharacterSets {
-		switch characterSet {
-		case CharacterSetDigits:
-			includeDigits = true
-		case CharacterSetSymbols:
-			includeSymbols = true
-		}
+// GeneratePassword generates a password for the recipe, locally when the SDK password generator can't express it.
+func GeneratePassword(recipe *GeneratorRecipe) (string, error) {
+	if !sdkSupportsRecipe(recipe) {
+		return generateRandomPassword(recipe)
 	}
 
-	passwordResponse, err := sdk.Secrets.GeneratePassword(
-		context.Background(),
-		sdk.NewPasswordRecipeTypeVariantRandom(&sdk.PasswordRecipeRandomInner{
-			IncludeDigits:  includeDigits,
-			IncludeSymbols: includeSymbols,
-			Length:         uint32(recipe.Length),
-		}),
-	)
+	passwordResponse, err := sdk.Secrets.GeneratePassword(context.Background(), toSDKPasswordRecipe(recipe))
 	if err != nil {
 		return "", err
 	}
@@ -549,17 +640,19 @@ func toConnectFields(fields []ItemField) ([]*connect.ItemField, error) {
 
 		// Include recipe if present
 		if f.Recipe != nil {
-			// Connect allows confiugration of letters for password recipes
-			// We need to include letters in the character sets in order to ensure they are not excluded
-			characterSets := []string{"LETTERS"}
-
-			for _, cs := range f.Recipe.CharacterSets {
-				characterSets = append(characterSets, string(cs))
-			}
-
-			field.Recipe = &connect.GeneratorRecipe{
-				Length:        f.Recipe.Length,
-				CharacterSets: characterSets,
+			if !connectSupportsRecipe(f.Recipe) {
+				// Connect recipes can't describe memorable passwords or minimum character counts,
+				// so these passwords are generated locally and sent as a value.
+				if f.Generate {
+					password, err := GeneratePassword(f.Recipe)
+					if err != nil {
+						return connectFields, fmt.Errorf("toConnectFields: failed to generate password: %w", err)
+					}
+					field.Value = password
+					field.Generate = false
+				}
+			} else {
+				field.Recipe = toConnectRecipe(f.Recipe)
 			}
 		}
 
@@ -593,42 +686,83 @@ func toConnectFiles(files []ItemFile) []*connect.File {
 }
 
 var modelToSdkFiledTypeMap = map[ItemFieldType]sdk.ItemFieldType{
-	FieldTypeConcealed: sdk.ItemFieldTypeConcealed,
-	FieldTypeDate:      sdk.ItemFieldTypeDate,
-	FieldTypeEmail:     sdk.ItemFieldTypeEmail,
-	FieldTypeMenu:      sdk.ItemFieldTypeMenu,
-	FieldTypeMonthYear: sdk.ItemFieldTypeMonthYear,
-	FieldTypeOTP:       sdk.ItemFieldTypeTOTP,
-	FieldTypeString:    sdk.ItemFieldTypeText,
-	FieldTypeURL:       sdk.ItemFieldTypeURL,
+	FieldTypeConcealed:        sdk.ItemFieldTypeConcealed,
+	FieldTypeCreditCardNumber: sdk.ItemFieldTypeCreditCardNumber,
+	FieldTypeCreditCardType:   sdk.ItemFieldTypeCreditCardType,
+	FieldTypeDate:             sdk.ItemFieldTypeDate,
+	FieldTypeEmail:            sdk.ItemFieldTypeEmail,
+	FieldTypeMenu:             sdk.ItemFieldTypeMenu,
+	FieldTypeMonthYear:        sdk.ItemFieldTypeMonthYear,
+	FieldTypeOTP:              sdk.ItemFieldTypeTOTP,
+	FieldTypePhone:            sdk.ItemFieldTypePhone,
+	FieldTypeReference:        sdk.ItemFieldTypeReference,
+	FieldTypeSSHKey:           sdk.ItemFieldTypeSSHKey,
+	FieldTypeString:           sdk.ItemFieldTypeText,
+	FieldTypeURL:              sdk.ItemFieldTypeURL,
 }
 
 func toSDKFieldType(filedType ItemFieldType) sdk.ItemFieldType {
 	return modelToSdkFiledTypeMap[filedType]
 }
 
+// sdkToModelFieldTypeMap lists the SDK field types that can be converted back to the SDK without losing data.
+// Addresses keep their value in details that the model doesn't hold.
 var sdkToModelFieldTypeMap = map[sdk.ItemFieldType]ItemFieldType{
-	sdk.ItemFieldTypeConcealed: FieldTypeConcealed,
-	sdk.ItemFieldTypeDate:      FieldTypeDate,
-	sdk.ItemFieldTypeEmail:     FieldTypeEmail,
-	sdk.ItemFieldTypeMenu:      FieldTypeMenu,
-	sdk.ItemFieldTypeMonthYear: FieldTypeMonthYear,
-	sdk.ItemFieldTypeTOTP:      FieldTypeOTP,
-	sdk.ItemFieldTypeText:      FieldTypeString,
-	sdk.ItemFieldTypeURL:       FieldTypeURL,
+	sdk.ItemFieldTypeConcealed:        FieldTypeConcealed,
+	sdk.ItemFieldTypeCreditCardNumber: FieldTypeCreditCardNumber,
+	sdk.ItemFieldTypeCreditCardType:   FieldTypeCreditCardType,
+	sdk.ItemFieldTypeDate:             FieldTypeDate,
+	sdk.ItemFieldTypeEmail:            FieldTypeEmail,
+	sdk.ItemFieldTypeMenu:             FieldTypeMenu,
+	sdk.ItemFieldTypeMonthYear:        FieldTypeMonthYear,
+	sdk.ItemFieldTypeTOTP:             FieldTypeOTP,
+	sdk.ItemFieldTypePhone:            FieldTypePhone,
+	sdk.ItemFieldTypeReference:        FieldTypeReference,
+	sdk.ItemFieldTypeSSHKey:           FieldTypeSSHKey,
+	sdk.ItemFieldTypeText:             FieldTypeString,
+	sdk.ItemFieldTypeURL:              FieldTypeURL,
 }
 
 func toModelFieldType(filedType sdk.ItemFieldType) ItemFieldType {
 	return sdkToModelFieldTypeMap[filedType]
 }
 
+var modelToSDKAutofillBehaviorMap = map[AutofillBehavior]sdk.AutofillBehavior{
+	AutofillBehaviorAnywhereOnWebsite: sdk.AutofillBehaviorAnywhereOnWebsite,
+	AutofillBehaviorExactDomain:       sdk.AutofillBehaviorExactDomain,
+	AutofillBehaviorNever:             sdk.AutofillBehaviorNever,
+}
+
+var sdkToModelAutofillBehaviorMap = map[sdk.AutofillBehavior]AutofillBehavior{
+	sdk.AutofillBehaviorAnywhereOnWebsite: AutofillBehaviorAnywhereOnWebsite,
+	sdk.AutofillBehaviorExactDomain:       AutofillBehaviorExactDomain,
+	sdk.AutofillBehaviorNever:             AutofillBehaviorNever,
+}
+
 var modelToSDKCategoryMap = map[ItemCategory]sdk.ItemCategory{
-	Login:      sdk.ItemCategoryLogin,
-	Password:   sdk.ItemCategoryPassword,
-	SecureNote: sdk.ItemCategorySecureNote,
-	Document:   sdk.ItemCategoryDocument,
-	SSHKey:     sdk.ItemCategorySSHKey,
-	Database:   sdk.ItemCategoryDatabase,
+	Login:                sdk.ItemCategoryLogin,
+	Password:             sdk.ItemCategoryPassword,
+	SecureNote:           sdk.ItemCategorySecureNote,
+	Document:             sdk.ItemCategoryDocument,
+	SSHKey:               sdk.ItemCategorySSHKey,
+	Database:             sdk.ItemCategoryDatabase,
+	APICredential:        sdk.ItemCategoryAPICredentials,
+	BankAccount:          sdk.ItemCategoryBankAccount,
+	CreditCard:           sdk.ItemCategoryCreditCard,
+	CryptoWallet:         sdk.ItemCategoryCryptoWallet,
+	DriverLicense:        sdk.ItemCategoryDriverLicense,
+	EmailAccount:         sdk.ItemCategoryEmail,
+	Identity:             sdk.ItemCategoryIdentity,
+	MedicalRecord:        sdk.ItemCategoryMedicalRecord,
+	Membership:           sdk.ItemCategoryMembership,
+	OutdoorLicense:       sdk.ItemCategoryOutdoorLicense,
+	Passport:             sdk.ItemCategoryPassport,
+	Person:               sdk.ItemCategoryPerson,
+	RewardProgram:        sdk.ItemCategoryRewards,
+	Server:               sdk.ItemCategoryServer,
+	SocialSecurityNumber: sdk.ItemCategorySocialSecurityNumber,
+	SoftwareLicense:      sdk.ItemCategorySoftwareLicense,
+	WirelessRouter:       sdk.ItemCategoryRouter,
 }
 
 func fromModelCategoryToSDK(itemCategory ItemCategory) sdk.ItemCategory {
@@ -636,13 +770,29 @@ func fromModelCategoryToSDK(itemCategory ItemCategory) sdk.ItemCategory {
 }
 
 var sdkToModelCategoryMap = map[sdk.ItemCategory]ItemCategory{
-	sdk.ItemCategoryLogin:          Login,
-	sdk.ItemCategoryPassword:       Password,
-	sdk.ItemCategorySecureNote:     SecureNote,
-	sdk.ItemCategoryDocument:       Document,
-	sdk.ItemCategorySSHKey:         SSHKey,
-	sdk.ItemCategoryDatabase:       Database,
-	sdk.ItemCategoryAPICredentials: APICredential,
+	sdk.ItemCategoryLogin:                Login,
+	sdk.ItemCategoryPassword:             Password,
+	sdk.ItemCategorySecureNote:           SecureNote,
+	sdk.ItemCategoryDocument:             Document,
+	sdk.ItemCategorySSHKey:               SSHKey,
+	sdk.ItemCategoryDatabase:             Database,
+	sdk.ItemCategoryAPICredentials:       APICredential,
+	sdk.ItemCategoryBankAccount:          BankAccount,
+	sdk.ItemCategoryCreditCard:           CreditCard,
+	sdk.ItemCategoryCryptoWallet:         CryptoWallet,
+	sdk.ItemCategoryDriverLicense:        DriverLicense,
+	sdk.ItemCategoryEmail:                EmailAccount,
+	sdk.ItemCategoryIdentity:             Identity,
+	sdk.ItemCategoryMedicalRecord:        MedicalRecord,
+	sdk.ItemCategoryMembership:           Membership,
+	sdk.ItemCategoryOutdoorLicense:       OutdoorLicense,
+	sdk.ItemCategoryPassport:             Passport,
+	sdk.ItemCategoryPerson:               Person,
+	sdk.ItemCategoryRewards:              RewardProgram,
+	sdk.ItemCategoryServer:               Server,
+	sdk.ItemCategorySocialSecurityNumber: SocialSecurityNumber,
+	sdk.ItemCategorySoftwareLicense:      SoftwareLicense,
+	sdk.ItemCategoryRouter:               WirelessRouter,
 }
 
 func fromSDKCategoryToModel(itemCategory sdk.ItemCategory) ItemCategory {
@@ -0,0 +1,54 @@
+package model
+
+// CategoryField describes a built-in field of an item category that is stored outside of any section.
+type CategoryField struct {
+	ID    string
+	Label string
+	Type  ItemFieldType
+}
+
+// categoryFields lists the categories that have no dedicated handling in the provider and their built-in fields.
+// Only the fields of categories verified against items created in 1Password are listed; the built-in fields of the
+// other categories can't be managed until their IDs are verified. Built-in fields that 1Password stores in sections
+// (e.g. the fields of an identity) are managed as section fields.
+var categoryFields = map[ItemCategory][]CategoryField{
+	BankAccount: {},
+	CreditCard: {
+		{ID: "cardholder", Label: "cardholder name", Type: FieldTypeString},
+		{ID: "type", Label: "type", Type: FieldTypeMenu},
+		{ID: "ccnum", Label: "number", Type: FieldTypeConcealed},
+		{ID: "cvv", Label: "verification number", Type: FieldTypeConcealed},
+		{ID: "expiry", Label: "expiry date", Type: FieldTypeMonthYear},
+		{ID: "validFrom", Label: "valid from", Type: FieldTypeMonthYear},
+	},
+	CryptoWallet:         {},
+	DriverLicense:        {},
+	EmailAccount:         {},
+	Identity:             {},
+	MedicalRecord:        {},
+	Membership:           {},
+	OutdoorLicense:       {},
+	Passport:             {},
+	Person:               {},
+	RewardProgram:        {},
+	Server:               {},
+	SocialSecurityNumber: {},
+	SoftwareLicense:      {},
+	WirelessRouter:       {},
+}
+
+// CategoryFields returns the built-in fields of a category and whether its built-in fields are described by this package.
+func CategoryFields(category ItemCategory) ([]CategoryField, bool) {
+	fields, ok := categoryFields[category]
+	return fields, ok
+}
+
+// FindCategoryField returns the built-in field of a category with the given ID.
+func FindCategoryField(category ItemCategory, id string) (CategoryField, bool) {
+	for _, f := range categoryFields[category] {
+		if f.ID == id {
+			return f, true
+		}
+	}
+	return CategoryField{}, false
+}
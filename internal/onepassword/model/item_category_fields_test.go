@@ -0,0 +1,93 @@
+package model
+
+import (
+	"testing"
+)
+
+func TestCategoryFields(t *testing.T) {
+	tests := map[string]struct {
+		category ItemCategory
+		expected bool
+	}{
+		"should describe CreditCard fields": {
+			category: CreditCard,
+			expected: true,
+		},
+		"should describe Identity without top-level fields": {
+			category: Identity,
+			expected: true,
+		},
+		"should describe Server without supported fields": {
+			category: Server,
+			expected: true,
+		},
+		"should not describe Login fields": {
+			category: Login,
+			expected: false,
+		},
+		"should not describe APICredential fields": {
+			category: APICredential,
+			expected: false,
+		},
+	}
+
+	for description, test := range tests {
+		t.Run(description, func(t *testing.T) {
+			_, actual := CategoryFields(test.category)
+			if actual != test.expected {
+				t.Errorf("Expected %v, got %v", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestCategoryFieldsAreUnique(t *testing.T) {
+	for category, fields := range categoryFields {
+		ids := map[string]bool{}
+		for _, f := range fields {
+			if ids[f.ID] {
+				t.Errorf("Duplicate field ID %q in category %s", f.ID, category)
+			}
+			ids[f.ID] = true
+		}
+	}
+}
+
+func TestFindCategoryField(t *testing.T) {
+	tests := map[string]struct {
+		category      ItemCategory
+		id            string
+		expectedType  ItemFieldType
+		expectedFound bool
+	}{
+		"should find credit card expiry": {
+			category:      CreditCard,
+			id:            "expiry",
+			expectedType:  FieldTypeMonthYear,
+			expectedFound: true,
+		},
+		"should find credit card verification number": {
+			category:      CreditCard,
+			id:            "cvv",
+			expectedType:  FieldTypeConcealed,
+			expectedFound: true,
+		},
+		"should not find field of another category": {
+			category:      Server,
+			id:            "cvv",
+			expectedFound: false,
+		},
+	}
+
+	for description, test := range tests {
+		t.Run(description, func(t *testing.T) {
+			field, found := FindCategoryField(test.category, test.id)
+			if found != test.expectedFound {
+				t.Fatalf("Expected found %v, got %v", test.expectedFound, found)
+			}
+			if field.Type != test.expectedType {
+				t.Errorf("Expected type %v, got %v", test.expectedType, field.Type)
+			}
+		})
+	}
+}
@@ -10,8 +10,11 @@ type ItemFile struct {
 	Size         int
 	SectionID    string
 	SectionLabel string
-	content      []byte
-	ContentPath  string
+	// FieldID is the ID of the field a file attached to a section is stored in.
+	// It is only set when using the 1Password SDK.
+	FieldID     string
+	content     []byte
+	ContentPath string
 }
 
 func (f *ItemFile) Content() ([]byte, error) {
@@ -0,0 +1,135 @@
+package model
+
+import "slices"
+
+// MergeUnmanaged returns the desired item together with the fields, sections, tags and websites of the current item
+// that are not managed, so that an update keeps the changes made to them outside of Terraform.
+// A value is managed when it is in the desired item or was in the previously managed item; values that were
+// previously managed and are no longer desired are removed. Files are not merged and are kept from the current item.
+func MergeUnmanaged(current, desired, previous *Item) *Item {
+	merged := *desired
+	merged.Files = current.Files
+
+	// Reuse the IDs of existing sections with the same label, so that fields are added to them
+	sectionIDs := make(map[string]string, len(current.Sections))
+	for _, s := range current.Sections {
+		sectionIDs[s.Label] = s.ID
+	}
+	merged.Sections = make([]ItemSection, 0, len(desired.Sections)+len(current.Sections))
+	managedSections := make(map[string]bool, len(desired.Sections))
+	for _, s := range desired.Sections {
+		if id, ok := sectionIDs[s.Label]; ok {
+			s.ID = id
+		}
+		merged.Sections = append(merged.Sections, s)
+		managedSections[s.Label] = true
+	}
+	merged.Fields = make([]ItemField, 0, len(desired.Fields)+len(current.Fields))
+	for _, f := range desired.Fields {
+		if id, ok := sectionIDs[f.SectionLabel]; ok && f.SectionID != "" {
+			f.SectionID = id
+		}
+		merged.Fields = append(merged.Fields, f)
+	}
+
+	managedFields := map[string]bool{}
+	for _, item := range []*Item{desired, previous} {
+		for _, f := range item.Fields {
+			for _, key := range fieldMergeKeys(f) {
+				managedFields[key] = true
+			}
+		}
+	}
+
+	keptSections := map[string]bool{}
+	for _, f := range current.Fields {
+		if isManagedField(f, managedFields) {
+			continue
+		}
+		merged.Fields = append(merged.Fields, f)
+		if f.SectionID != "" {
+			keptSections[f.SectionID] = true
+		}
+	}
+
+	previousSections := make(map[string]bool, len(previous.Sections))
+	for _, s := range previous.Sections {
+		previousSections[s.Label] = true
+	}
+	for _, s := range current.Sections {
+		if managedSections[s.Label] {
+			continue
+		}
+		// Sections that are no longer managed are only kept for their unmanaged fields
+		if previousSections[s.Label] && !keptSections[s.ID] {
+			continue
+		}
+		merged.Sections = append(merged.Sections, s)
+	}
+
+	merged.Tags = mergeUnmanagedValues(current.Tags, desired.Tags, previous.Tags)
+
+	desiredURLs := make([]string, 0, len(desired.URLs))
+	for _, u := range desired.URLs {
+		desiredURLs = append(desiredURLs, u.URL)
+	}
+	previousURLs := make([]string, 0, len(previous.URLs))
+	for _, u := range previous.URLs {
+		previousURLs = append(previousURLs, u.URL)
+	}
+	hasPrimary := false
+	for _, u := range desired.URLs {
+		hasPrimary = hasPrimary || u.Primary
+	}
+	merged.URLs = append([]ItemURL{}, desired.URLs...)
+	for _, u := range current.URLs {
+		if slices.Contains(desiredURLs, u.URL) || slices.Contains(previousURLs, u.URL) {
+			continue
+		}
+		// Only one website can be primary
+		u.Primary = u.Primary && !hasPrimary
+		merged.URLs = append(merged.URLs, u)
+	}
+
+	return &merged
+}
+
+// fieldMergeKeys returns the keys that identify a field: its section and label for fields in sections,
+// and its purpose, ID and label for fields outside sections.
+func fieldMergeKeys(f ItemField) []string {
+	if f.SectionID != "" {
+		return []string{"section:" + f.SectionLabel + "/" + f.Label}
+	}
+
+	var keys []string
+	if f.Purpose != "" {
+		keys = append(keys, "purpose:"+string(f.Purpose))
+	}
+	if f.ID != "" {
+		keys = append(keys, "field:"+f.ID)
+	}
+	if f.Label != "" {
+		keys = append(keys, "field:"+f.Label)
+	}
+	return keys
+}
+
+func isManagedField(f ItemField, managedFields map[string]bool) bool {
+	for _, key := range fieldMergeKeys(f) {
+		if managedFields[key] {
+			return true
+		}
+	}
+	return false
+}
+
+// mergeUnmanagedValues returns the desired values together with the current values that were not previously managed.
+func mergeUnmanagedValues(current, desired, previous []string) []string {
+	merged := append([]string{}, desired...)
+	for _, v := range current {
+		if !slices.Contains(desired, v) && !slices.Contains(previous, v) {
+			merged = append(merged, v)
+		}
+	}
+	return merged
+}
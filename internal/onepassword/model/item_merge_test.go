@@ -0,0 +1,111 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMergeUnmanaged(t *testing.T) {
+	current := &Item{
+		ID:    "item1",
+		Title: "Old title",
+		Tags:  []string{"managed", "added-in-app"},
+		URLs: []ItemURL{
+			{URL: "https://example.com", Primary: true},
+			{URL: "https://added.example.com"},
+		},
+		Sections: []ItemSection{
+			{ID: "s1", Label: "managed"},
+			{ID: "s2", Label: "added in app"},
+			{ID: "s3", Label: "removed"},
+		},
+		Fields: []ItemField{
+			{ID: "username", Label: "username", Purpose: FieldPurposeUsername, Value: "old"},
+			{ID: "password", Label: "password", Purpose: FieldPurposePassword, Value: "secret"},
+			{Purpose: FieldPurposeNotes, Value: "notes added in app"},
+			{ID: "f1", Label: "host", SectionID: "s1", SectionLabel: "managed", Value: "old.example.com"},
+			{ID: "f2", Label: "added", SectionID: "s1", SectionLabel: "managed", Value: "kept"},
+			{ID: "f3", Label: "one-time password", Type: FieldTypeOTP, SectionID: "s2", SectionLabel: "added in app", Value: "otpauth://totp/example"},
+			{ID: "f4", Label: "removed", SectionID: "s3", SectionLabel: "removed", Value: "deleted"},
+		},
+		Files: []ItemFile{{ID: "file1", Name: "attachment.txt", SectionID: "s2"}},
+	}
+	previous := &Item{
+		Tags: []string{"managed"},
+		URLs: []ItemURL{{URL: "https://example.com", Primary: true}},
+		Sections: []ItemSection{
+			{ID: "s1", Label: "managed"},
+			{ID: "s3", Label: "removed"},
+		},
+		Fields: []ItemField{
+			{ID: "username", Label: "username", Purpose: FieldPurposeUsername, Value: "old"},
+			{ID: "password", Label: "password", Purpose: FieldPurposePassword, Value: "secret"},
+			{ID: "f1", Label: "host", SectionID: "s1", SectionLabel: "managed", Value: "old.example.com"},
+			{ID: "f4", Label: "removed", SectionID: "s3", SectionLabel: "removed", Value: "deleted"},
+		},
+	}
+	desired := &Item{
+		ID:    "item1",
+		Title: "New title",
+		Tags:  []string{"managed"},
+		URLs:  []ItemURL{{URL: "https://example.com", Primary: true}},
+		Sections: []ItemSection{
+			{ID: "s1", Label: "managed"},
+			{ID: "generated", Label: "added in app"},
+		},
+		Fields: []ItemField{
+			{ID: "username", Label: "username", Purpose: FieldPurposeUsername, Value: "new"},
+			{ID: "password", Label: "password", Purpose: FieldPurposePassword, Value: "secret"},
+			{ID: "f1", Label: "host", SectionID: "s1", SectionLabel: "managed", Value: "new.example.com"},
+			{ID: "f5", Label: "region", SectionID: "generated", SectionLabel: "added in app", Value: "eu"},
+		},
+	}
+
+	expected := &Item{
+		ID:    "item1",
+		Title: "New title",
+		Tags:  []string{"managed", "added-in-app"},
+		URLs: []ItemURL{
+			{URL: "https://example.com", Primary: true},
+			{URL: "https://added.example.com"},
+		},
+		Sections: []ItemSection{
+			{ID: "s1", Label: "managed"},
+			{ID: "s2", Label: "added in app"},
+		},
+		Fields: []ItemField{
+			{ID: "username", Label: "username", Purpose: FieldPurposeUsername, Value: "new"},
+			{ID: "password", Label: "password", Purpose: FieldPurposePassword, Value: "secret"},
+			{ID: "f1", Label: "host", SectionID: "s1", SectionLabel: "managed", Value: "new.example.com"},
+			{ID: "f5", Label: "region", SectionID: "s2", SectionLabel: "added in app", Value: "eu"},
+			{Purpose: FieldPurposeNotes, Value: "notes added in app"},
+			{ID: "f2", Label: "added", SectionID: "s1", SectionLabel: "managed", Value: "kept"},
+			{ID: "f3", Label: "one-time password", Type: FieldTypeOTP, SectionID: "s2", SectionLabel: "added in app", Value: "otpauth://totp/example"},
+		},
+		Files: []ItemFile{{ID: "file1", Name: "attachment.txt", SectionID: "s2"}},
+	}
+
+	actual := MergeUnmanaged(current, desired, previous)
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("Item mismatch:\ngot      %+v\nexpected %+v", actual, expected)
+	}
+}
+
+func TestMergeUnmanagedPrimaryURL(t *testing.T) {
+	current := &Item{
+		URLs: []ItemURL{{URL: "https://added.example.com", Primary: true}},
+	}
+	desired := &Item{
+		URLs: []ItemURL{{URL: "https://example.com", Primary: true}},
+	}
+
+	expected := []ItemURL{
+		{URL: "https://example.com", Primary: true},
+		{URL: "https://added.example.com"},
+	}
+
+	actual := MergeUnmanaged(current, desired, &Item{})
+	if !reflect.DeepEqual(actual.URLs, expected) {
+		t.Errorf("URLs mismatch: got %+v, expected %+v", actual.URLs, expected)
+	}
+}
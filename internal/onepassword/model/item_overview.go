@@ -0,0 +1,50 @@
+package model
+
+import (
+	"time"
+
+	connect "github.com/1Password/connect-sdk-go/onepassword"
+	sdk "github.com/1password/onepassword-sdk-go"
+)
+
+// ItemOverview holds the non-secret metadata of an item as returned when listing the items of a vault.
+type ItemOverview struct {
+	ID        string
+	Title     string
+	VaultID   string
+	Category  ItemCategory
+	Tags      []string
+	URLs      []string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (o *ItemOverview) FromSDKItemOverview(item *sdk.ItemOverview) {
+	o.ID = item.ID
+	o.Title = item.Title
+	o.VaultID = item.VaultID
+	o.Category = fromSDKCategoryToModel(item.Category)
+	o.Tags = item.Tags
+	o.CreatedAt = item.CreatedAt
+	o.UpdatedAt = item.UpdatedAt
+
+	o.URLs = make([]string, len(item.Websites))
+	for i, website := range item.Websites {
+		o.URLs[i] = website.URL
+	}
+}
+
+func (o *ItemOverview) FromConnectItemOverview(item *connect.Item) {
+	o.ID = item.ID
+	o.Title = item.Title
+	o.VaultID = item.Vault.ID
+	o.Category = ItemCategory(item.Category)
+	o.Tags = item.Tags
+	o.CreatedAt = item.CreatedAt
+	o.UpdatedAt = item.UpdatedAt
+
+	o.URLs = make([]string, len(item.URLs))
+	for i, url := range item.URLs {
+		o.URLs[i] = url.URL
+	}
+}
@@ -0,0 +1,116 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	connect "github.com/1Password/connect-sdk-go/onepassword"
+	sdk "github.com/1password/onepassword-sdk-go"
+)
+
+func TestFromSDKItemOverview(t *testing.T) {
+	tests := map[string]struct {
+		input    *sdk.ItemOverview
+		expected *ItemOverview
+	}{
+		"should convert complete overview": {
+			input: &sdk.ItemOverview{
+				ID:        "item1",
+				Title:     "Test Item",
+				Category:  sdk.ItemCategoryLogin,
+				VaultID:   "vault1",
+				Websites:  []sdk.Website{{URL: "https://example.com", Label: "website"}},
+				Tags:      []string{"prod"},
+				CreatedAt: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+				UpdatedAt: time.Date(2024, 6, 7, 8, 9, 10, 0, time.UTC),
+			},
+			expected: &ItemOverview{
+				ID:        "item1",
+				Title:     "Test Item",
+				VaultID:   "vault1",
+				Category:  Login,
+				Tags:      []string{"prod"},
+				URLs:      []string{"https://example.com"},
+				CreatedAt: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+				UpdatedAt: time.Date(2024, 6, 7, 8, 9, 10, 0, time.UTC),
+			},
+		},
+		"should handle overview without websites": {
+			input: &sdk.ItemOverview{
+				ID:       "item1",
+				Category: sdk.ItemCategoryDatabase,
+				VaultID:  "vault1",
+			},
+			expected: &ItemOverview{
+				ID:       "item1",
+				VaultID:  "vault1",
+				Category: Database,
+				URLs:     []string{},
+			},
+		},
+	}
+
+	for description, test := range tests {
+		t.Run(description, func(t *testing.T) {
+			overview := &ItemOverview{}
+			overview.FromSDKItemOverview(test.input)
+			if !reflect.DeepEqual(overview, test.expected) {
+				t.Errorf("Expected %+v, got %+v", test.expected, overview)
+			}
+		})
+	}
+}
+
+func TestFromConnectItemOverview(t *testing.T) {
+	tests := map[string]struct {
+		input    *connect.Item
+		expected *ItemOverview
+	}{
+		"should convert complete overview": {
+			input: &connect.Item{
+				ID:        "item1",
+				Title:     "Test Item",
+				Vault:     connect.ItemVault{ID: "vault1"},
+				Category:  connect.Login,
+				Tags:      []string{"prod"},
+				URLs:      []connect.ItemURL{{URL: "https://example.com", Primary: true}},
+				CreatedAt: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+				UpdatedAt: time.Date(2024, 6, 7, 8, 9, 10, 0, time.UTC),
+			},
+			expected: &ItemOverview{
+				ID:        "item1",
+				Title:     "Test Item",
+				VaultID:   "vault1",
+				Category:  Login,
+				Tags:      []string{"prod"},
+				URLs:      []string{"https://example.com"},
+				CreatedAt: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+				UpdatedAt: time.Date(2024, 6, 7, 8, 9, 10, 0, time.UTC),
+			},
+		},
+		"should handle overview without urls": {
+			input: &connect.Item{
+				ID:       "item1",
+				Vault:    connect.ItemVault{ID: "vault1"},
+				Category: connect.Database,
+			},
+			expected: &ItemOverview{
+				ID:       "item1",
+				VaultID:  "vault1",
+				Category: Database,
+				URLs:     []string{},
+			},
+		},
+	}
+
+	for description, test := range tests {
+		t.Run(description, func(t *testing.T) {
+			overview := &ItemOverview{}
+			overview.FromConnectItemOverview(test.input)
+			if !reflect.DeepEqual(overview, test.expected) {
+				t.Errorf("Expected %+v, got %+v", test.expected, overview)
+			}
+		})
+	}
+}
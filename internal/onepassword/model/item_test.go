@@ -3,6 +3,7 @@ package model
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	connect "github.com/1Password/connect-sdk-go/onepassword"
 	sdk "github.com/1password/onepassword-sdk-go"
@@ -41,6 +42,26 @@ func TestFromModelCategoryToSDK(t *testing.T) {
 			input:    Database,
 			expected: sdk.ItemCategoryDatabase,
 		},
+		"should convert APICredential category": {
+			input:    APICredential,
+			expected: sdk.ItemCategoryAPICredentials,
+		},
+		"should convert CreditCard category": {
+			input:    CreditCard,
+			expected: sdk.ItemCategoryCreditCard,
+		},
+		"should convert EmailAccount category": {
+			input:    EmailAccount,
+			expected: sdk.ItemCategoryEmail,
+		},
+		"should convert RewardProgram category": {
+			input:    RewardProgram,
+			expected: sdk.ItemCategoryRewards,
+		},
+		"should convert WirelessRouter category": {
+			input:    WirelessRouter,
+			expected: sdk.ItemCategoryRouter,
+		},
 		"should return zero value for unknown category": {
 			input:    ItemCategory("UNKNOWN"),
 			expected: sdk.ItemCategory(""),
@@ -86,6 +107,18 @@ func TestFromSDKCategoryToModel(t *testing.T) {
 			input:    sdk.ItemCategoryDatabase,
 			expected: Database,
 		},
+		"should convert Server category": {
+			input:    sdk.ItemCategoryServer,
+			expected: Server,
+		},
+		"should convert CreditCard category": {
+			input:    sdk.ItemCategoryCreditCard,
+			expected: CreditCard,
+		},
+		"should convert Router category": {
+			input:    sdk.ItemCategoryRouter,
+			expected: WirelessRouter,
+		},
 		"should return zero value for unknown category": {
 			input:    sdk.ItemCategory("UNKNOWN"),
 			expected: ItemCategory(""),
@@ -217,6 +250,23 @@ func TestCategoryConversionRoundTrip(t *testing.T) {
 		Document,
 		SSHKey,
 		Database,
+		APICredential,
+		BankAccount,
+		CreditCard,
+		CryptoWallet,
+		DriverLicense,
+		EmailAccount,
+		Identity,
+		MedicalRecord,
+		Membership,
+		OutdoorLicense,
+		Passport,
+		Person,
+		RewardProgram,
+		Server,
+		SocialSecurityNumber,
+		SoftwareLicense,
+		WirelessRouter,
 	}
 
 	for _, category := range categories {
@@ -288,6 +338,14 @@ func TestFromSDKURLs(t *testing.T) {
 				{URL: "https://example.com", Label: "", Primary: true},
 			},
 		},
+		"should convert autofill behavior": {
+			input: []sdk.Website{
+				{URL: "https://example.com", Label: "Example", AutofillBehavior: sdk.AutofillBehaviorExactDomain},
+			},
+			expected: []ItemURL{
+				{URL: "https://example.com", Label: "Example", Primary: true, AutofillBehavior: AutofillBehaviorExactDomain},
+			},
+		},
 	}
 
 	for description, test := range tests {
@@ -955,7 +1013,10 @@ func TestToSDKFields(t *testing.T) {
 
 	for description, test := range tests {
 		t.Run(description, func(t *testing.T) {
-			actualFields, actualNotes := toSDKFields(test.inputFields)
+			actualFields, actualNotes, err := toSDKFields(test.inputFields)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
 			if len(actualFields) != len(test.expectedFields) {
 				t.Errorf("Expected %d fields, got %d", len(test.expectedFields), len(actualFields))
 				return
@@ -981,8 +1042,9 @@ func TestToSDKFields(t *testing.T) {
 
 func TestToSDKField(t *testing.T) {
 	tests := map[string]struct {
-		input    ItemField
-		expected sdk.ItemField
+		input       ItemField
+		expected    sdk.ItemField
+		expectedErr bool
 	}{
 		"should convert basic field": {
 			input: ItemField{
@@ -1042,11 +1104,30 @@ func TestToSDKField(t *testing.T) {
 				Value:     "test@example.com",
 			},
 		},
+		"should return error when the password can't be generated": {
+			input: ItemField{
+				ID:       "password",
+				Label:    "password",
+				Type:     FieldTypeConcealed,
+				Generate: true,
+				Recipe: &GeneratorRecipe{
+					Length:         4,
+					ExcludeLetters: true,
+					CharacterSets:  []CharacterSet{CharacterSetDigits},
+					MinDigits:      5,
+				},
+			},
+			expected:    sdk.ItemField{},
+			expectedErr: true,
+		},
 	}
 
 	for description, test := range tests {
 		t.Run(description, func(t *testing.T) {
-			actual := toSDKField(test.input)
+			actual, err := toSDKField(test.input)
+			if (err != nil) != test.expectedErr {
+				t.Fatalf("Expected error %v, got %v", test.expectedErr, err)
+			}
 			if !reflect.DeepEqual(actual, test.expected) {
 				t.Errorf("Field mismatch: expected %+v, got %+v", test.expected, actual)
 			}
@@ -1148,6 +1229,26 @@ func TestToSDKWebsites(t *testing.T) {
 				{URL: "https://example.com", Label: ""},
 			},
 		},
+		"should move primary URL first": {
+			input: []ItemURL{
+				{URL: "https://example.com", Label: "Example"},
+				{URL: "https://login.example.com", Label: "Sign in", Primary: true},
+			},
+			expected: []sdk.Website{
+				{URL: "https://login.example.com", Label: "Sign in"},
+				{URL: "https://example.com", Label: "Example"},
+			},
+		},
+		"should convert autofill behavior": {
+			input: []ItemURL{
+				{URL: "https://example.com", Label: "Example", AutofillBehavior: AutofillBehaviorExactDomain},
+				{URL: "https://test.com", Label: "Test", AutofillBehavior: AutofillBehaviorNever},
+			},
+			expected: []sdk.Website{
+				{URL: "https://example.com", Label: "Example", AutofillBehavior: sdk.AutofillBehaviorExactDomain},
+				{URL: "https://test.com", Label: "Test", AutofillBehavior: sdk.AutofillBehaviorNever},
+			},
+		},
 	}
 
 	for description, test := range tests {
@@ -1174,6 +1275,8 @@ func TestToSDKWebsites(t *testing.T) {
 func TestFromSDKItemToModel(t *testing.T) {
 	sectionID := "section1"
 	notesValue := "Test notes"
+	createdAt := time.Date(2024, time.January, 2, 15, 4, 5, 0, time.UTC)
+	updatedAt := time.Date(2024, time.March, 4, 10, 0, 0, 0, time.UTC)
 
 	tests := map[string]struct {
 		input    *sdk.Item
@@ -1184,6 +1287,31 @@ func TestFromSDKItemToModel(t *testing.T) {
 			input:   nil,
 			wantErr: true,
 		},
+		"should convert item metadata": {
+			input: &sdk.Item{
+				ID:        "item1",
+				Title:     "Test Item",
+				VaultID:   "vault1",
+				Category:  sdk.ItemCategoryLogin,
+				Version:   3,
+				CreatedAt: createdAt,
+				UpdatedAt: updatedAt,
+			},
+			expected: &Item{
+				ID:        "item1",
+				Title:     "Test Item",
+				VaultID:   "vault1",
+				Category:  Login,
+				Version:   3,
+				CreatedAt: createdAt,
+				UpdatedAt: updatedAt,
+				URLs:      []ItemURL{},
+				Sections:  []ItemSection{},
+				Fields:    []ItemField{},
+				Files:     []ItemFile{},
+			},
+			wantErr: false,
+		},
 		"should convert basic item": {
 			input: &sdk.Item{
 				ID:       "item1",
@@ -1518,11 +1646,37 @@ func TestFromModelItemToSDKCreateParams(t *testing.T) {
 				Files:  []sdk.FileCreateParams{},
 			},
 		},
+		"should convert document item with file content": {
+			input: &Item{
+				VaultID:  "vault1",
+				Title:    "Test Document",
+				Category: Document,
+				Files: []ItemFile{
+					{Name: "attachment.txt", SectionID: sectionID, content: []byte("attachment")},
+					{Name: "ca.pem", content: []byte("certificate")},
+				},
+			},
+			expected: sdk.ItemCreateParams{
+				VaultID:  "vault1",
+				Title:    "Test Document",
+				Category: sdk.ItemCategoryDocument,
+				Sections: []sdk.ItemSection{},
+				Websites: []sdk.Website{},
+				Fields:   []sdk.ItemField{},
+				Document: &sdk.DocumentCreateParams{
+					Name:    "ca.pem",
+					Content: []byte("certificate"),
+				},
+			},
+		},
 	}
 
 	for description, test := range tests {
 		t.Run(description, func(t *testing.T) {
-			actual := test.input.FromModelItemToSDKCreateParams()
+			actual, err := test.input.FromModelItemToSDKCreateParams()
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
 			if actual.VaultID != test.expected.VaultID ||
 				actual.Title != test.expected.Title ||
 				actual.Category != test.expected.Category {
@@ -1543,6 +1697,9 @@ func TestFromModelItemToSDKCreateParams(t *testing.T) {
 			if !reflect.DeepEqual(actual.Notes, test.expected.Notes) {
 				t.Errorf("Notes mismatch: got %+v, expected %+v", actual.Notes, test.expected.Notes)
 			}
+			if !reflect.DeepEqual(actual.Document, test.expected.Document) {
+				t.Errorf("Document mismatch: got %+v, expected %+v", actual.Document, test.expected.Document)
+			}
 		})
 	}
 }
@@ -2057,6 +2214,28 @@ func TestToConnectFields(t *testing.T) {
 				},
 			},
 		},
+		"should convert PIN recipe to digits only": {
+			input: []ItemField{
+				{
+					ID:   "pin",
+					Type: FieldTypeConcealed,
+					Recipe: &GeneratorRecipe{
+						Type:   RecipeTypePin,
+						Length: 6,
+					},
+				},
+			},
+			expected: []*connect.ItemField{
+				{
+					ID:   "pin",
+					Type: connect.FieldTypeConcealed,
+					Recipe: &connect.GeneratorRecipe{
+						Length:        6,
+						CharacterSets: []string{"DIGITS"},
+					},
+				},
+			},
+		},
 		"should convert all field types": {
 			input: []ItemField{
 				{ID: "f1", Type: FieldTypeConcealed, Value: "secret"},
@@ -2206,6 +2385,9 @@ func TestToConnectFiles(t *testing.T) {
 }
 
 func TestFromConnectItemToModel(t *testing.T) {
+	createdAt := time.Date(2024, time.January, 2, 15, 4, 5, 0, time.UTC)
+	updatedAt := time.Date(2024, time.March, 4, 10, 0, 0, 0, time.UTC)
+
 	tests := map[string]struct {
 		input    *connect.Item
 		expected *Item
@@ -2215,6 +2397,35 @@ func TestFromConnectItemToModel(t *testing.T) {
 			input:   nil,
 			wantErr: true,
 		},
+		"should convert item metadata": {
+			input: &connect.Item{
+				ID:           "item1",
+				Title:        "Test Item",
+				Vault:        connect.ItemVault{ID: "vault1"},
+				Category:     connect.ItemCategory("LOGIN"),
+				Version:      2,
+				Favorite:     true,
+				LastEditedBy: "user1",
+				CreatedAt:    createdAt,
+				UpdatedAt:    updatedAt,
+			},
+			expected: &Item{
+				ID:           "item1",
+				Title:        "Test Item",
+				VaultID:      "vault1",
+				Category:     Login,
+				Version:      2,
+				Favorite:     true,
+				LastEditedBy: "user1",
+				CreatedAt:    createdAt,
+				UpdatedAt:    updatedAt,
+				URLs:         []ItemURL{},
+				Sections:     []ItemSection{},
+				Fields:       []ItemField{},
+				Files:        []ItemFile{},
+			},
+			wantErr: false,
+		},
 		"should convert basic item": {
 			input: &connect.Item{
 				ID:       "item1",
@@ -2453,3 +2664,25 @@ func TestFromModelItemToConnect(t *testing.T) {
 		})
 	}
 }
+
+func TestFromSDKItemToModelUnsupportedFields(t *testing.T) {
+	item := &Item{}
+	err := item.FromSDKItemToModel(&sdk.Item{
+		ID:       "item1",
+		Category: sdk.ItemCategoryCreditCard,
+		Fields: []sdk.ItemField{
+			{ID: "ccnum", Title: "number", FieldType: sdk.ItemFieldTypeCreditCardNumber, Value: "4111111111111111"},
+			{ID: "address", Title: "billing address", FieldType: sdk.ItemFieldTypeAddress},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if item.Fields[0].Type != FieldTypeCreditCardNumber {
+		t.Errorf("Expected type %s, got %s", FieldTypeCreditCardNumber, item.Fields[0].Type)
+	}
+	if expected := []string{"billing address"}; !reflect.DeepEqual(item.UnsupportedFields(), expected) {
+		t.Errorf("Expected unsupported fields %v, got %v", expected, item.UnsupportedFields())
+	}
+}
@@ -1,6 +1,8 @@
 package model
 
 import (
+	"time"
+
 	connect "github.com/1Password/connect-sdk-go/onepassword"
 	sdk "github.com/1password/onepassword-sdk-go"
 )
@@ -9,12 +11,18 @@ type Vault struct {
 	ID          string
 	Name        string
 	Description string
+	ItemCount   int
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
 }
 
 func (v *Vault) FromConnectVault(vault *connect.Vault) {
 	v.ID = vault.ID
 	v.Name = vault.Name
 	v.Description = vault.Description
+	v.ItemCount = vault.Items
+	v.CreatedAt = vault.CreatedAt
+	v.UpdatedAt = vault.UpdatedAt
 }
 
 func (v *Vault) ToConnectVault() *connect.Vault {
@@ -22,6 +30,9 @@ func (v *Vault) ToConnectVault() *connect.Vault {
 		ID:          v.ID,
 		Name:        v.Name,
 		Description: v.Description,
+		Items:       v.ItemCount,
+		CreatedAt:   v.CreatedAt,
+		UpdatedAt:   v.UpdatedAt,
 	}
 }
 
@@ -29,4 +40,14 @@ func (v *Vault) FromSDKVault(vault *sdk.VaultOverview) {
 	v.ID = vault.ID
 	v.Name = vault.Title
 	v.Description = vault.Description
+	v.ItemCount = int(vault.ActiveItemCount)
+	v.CreatedAt = vault.CreatedAt
+	v.UpdatedAt = vault.UpdatedAt
+}
+
+func (v *Vault) FromSDKFullVault(vault *sdk.Vault) {
+	v.ID = vault.ID
+	v.Name = vault.Title
+	v.Description = vault.Description
+	v.ItemCount = int(vault.ActiveItemCount)
 }
@@ -0,0 +1,91 @@
+package model
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/1password/onepassword-sdk-go"
+)
+
+type VaultAccessorType string
+
+const (
+	VaultAccessorTypeUser  VaultAccessorType = "USER"
+	VaultAccessorTypeGroup VaultAccessorType = "GROUP"
+)
+
+// VaultAccess describes the permissions a user or group has on a vault.
+type VaultAccess struct {
+	VaultID      string
+	AccessorType VaultAccessorType
+	AccessorID   string
+	Permissions  uint32
+}
+
+// vaultPermissions maps the permission names used by the provider to the 1Password permission bits.
+var vaultPermissions = map[string]uint32{
+	"recover_vault":        sdk.RecoverVault,
+	"manage_vault":         sdk.ManageVault,
+	"reveal_item_password": sdk.RevealItemPassword,
+	"read_items":           sdk.ReadItems,
+	"update_items":         sdk.UpdateItems,
+	"create_items":         sdk.CreateItems,
+	"archive_items":        sdk.ArchiveItems,
+	"delete_items":         sdk.DeleteItems,
+	"update_item_history":  sdk.UpdateItemHistory,
+	"import_items":         sdk.ImportItems,
+	"send_items":           sdk.SendItems,
+	"export_items":         sdk.ExportItems,
+	"print_items":          sdk.PrintItems,
+}
+
+// VaultPermissionNames returns the sorted list of permission names that can be granted on a vault.
+func VaultPermissionNames() []string {
+	names := make([]string, 0, len(vaultPermissions))
+	for name := range vaultPermissions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// VaultPermissionsFromNames converts a list of permission names to 1Password permission bits.
+func VaultPermissionsFromNames(names []string) (uint32, error) {
+	var permissions uint32
+	for _, name := range names {
+		bit, ok := vaultPermissions[name]
+		if !ok {
+			return 0, fmt.Errorf("unknown vault permission %q", name)
+		}
+		permissions |= bit
+	}
+	return permissions, nil
+}
+
+// VaultPermissionsToNames converts 1Password permission bits to a sorted list of permission names.
+// Bits that don't have a known name are returned as unknown.
+func VaultPermissionsToNames(permissions uint32) (names []string, unknown uint32) {
+	names = make([]string, 0, len(vaultPermissions))
+	unknown = permissions
+	for name, bit := range vaultPermissions {
+		if permissions&bit != 0 {
+			names = append(names, name)
+			unknown &^= bit
+		}
+	}
+	sort.Strings(names)
+	return names, unknown
+}
+
+func (a *VaultAccess) FromSDKVaultAccess(access *sdk.VaultAccess) {
+	a.VaultID = access.VaultUuid
+	a.AccessorID = access.AccessorUuid
+	a.Permissions = access.Permissions
+
+	switch access.AccessorType {
+	case sdk.VaultAccessorTypeGroup:
+		a.AccessorType = VaultAccessorTypeGroup
+	case sdk.VaultAccessorTypeUser:
+		a.AccessorType = VaultAccessorTypeUser
+	}
+}
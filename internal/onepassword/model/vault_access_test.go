@@ -0,0 +1,127 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+
+	sdk "github.com/1password/onepassword-sdk-go"
+)
+
+func TestVaultPermissionsFromNames(t *testing.T) {
+	tests := map[string]struct {
+		input       []string
+		expected    uint32
+		expectError bool
+	}{
+		"should return zero for no permissions": {
+			input:    []string{},
+			expected: 0,
+		},
+		"should combine permissions": {
+			input:    []string{"read_items", "reveal_item_password", "manage_vault"},
+			expected: sdk.ReadItems | sdk.RevealItemPassword | sdk.ManageVault,
+		},
+		"should error on unknown permission": {
+			input:       []string{"read_items", "fly"},
+			expectError: true,
+		},
+	}
+
+	for description, test := range tests {
+		t.Run(description, func(t *testing.T) {
+			permissions, err := VaultPermissionsFromNames(test.input)
+			if test.expectError {
+				if err == nil {
+					t.Errorf("Expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if permissions != test.expected {
+				t.Errorf("Expected %d, got %d", test.expected, permissions)
+			}
+		})
+	}
+}
+
+func TestVaultPermissionsToNames(t *testing.T) {
+	tests := map[string]struct {
+		input           uint32
+		expected        []string
+		expectedUnknown uint32
+	}{
+		"should return empty list for no permissions": {
+			input:    0,
+			expected: []string{},
+		},
+		"should return sorted names": {
+			input:    sdk.ReadItems | sdk.RevealItemPassword | sdk.ManageVault,
+			expected: []string{"manage_vault", "read_items", "reveal_item_password"},
+		},
+		"should return unknown bits": {
+			input:           sdk.ReadItems | 1<<30,
+			expected:        []string{"read_items"},
+			expectedUnknown: 1 << 30,
+		},
+	}
+
+	for description, test := range tests {
+		t.Run(description, func(t *testing.T) {
+			names, unknown := VaultPermissionsToNames(test.input)
+			if !reflect.DeepEqual(names, test.expected) {
+				t.Errorf("Expected %v, got %v", test.expected, names)
+			}
+			if unknown != test.expectedUnknown {
+				t.Errorf("Expected unknown bits %#x, got %#x", test.expectedUnknown, unknown)
+			}
+		})
+	}
+}
+
+func TestFromSDKVaultAccess(t *testing.T) {
+	tests := map[string]struct {
+		input    *sdk.VaultAccess
+		expected *VaultAccess
+	}{
+		"should convert group access": {
+			input: &sdk.VaultAccess{
+				VaultUuid:    "vault1",
+				AccessorType: sdk.VaultAccessorTypeGroup,
+				AccessorUuid: "group1",
+				Permissions:  sdk.ReadItems,
+			},
+			expected: &VaultAccess{
+				VaultID:      "vault1",
+				AccessorType: VaultAccessorTypeGroup,
+				AccessorID:   "group1",
+				Permissions:  sdk.ReadItems,
+			},
+		},
+		"should convert user access": {
+			input: &sdk.VaultAccess{
+				VaultUuid:    "vault1",
+				AccessorType: sdk.VaultAccessorTypeUser,
+				AccessorUuid: "user1",
+				Permissions:  sdk.ManageVault,
+			},
+			expected: &VaultAccess{
+				VaultID:      "vault1",
+				AccessorType: VaultAccessorTypeUser,
+				AccessorID:   "user1",
+				Permissions:  sdk.ManageVault,
+			},
+		},
+	}
+
+	for description, test := range tests {
+		t.Run(description, func(t *testing.T) {
+			access := &VaultAccess{}
+			access.FromSDKVaultAccess(test.input)
+			if !reflect.DeepEqual(access, test.expected) {
+				t.Errorf("Expected %+v, got %+v", test.expected, access)
+			}
+		})
+	}
+}
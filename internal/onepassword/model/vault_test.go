@@ -3,6 +3,7 @@ package model
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	connect "github.com/1Password/connect-sdk-go/onepassword"
 	sdk "github.com/1password/onepassword-sdk-go"
@@ -18,11 +19,17 @@ func TestFromConnectVault(t *testing.T) {
 				ID:          "vault1",
 				Name:        "Test Vault",
 				Description: "Test Description",
+				Items:       5,
+				CreatedAt:   time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+				UpdatedAt:   time.Date(2024, 6, 7, 8, 9, 10, 0, time.UTC),
 			},
 			expected: &Vault{
 				ID:          "vault1",
 				Name:        "Test Vault",
 				Description: "Test Description",
+				ItemCount:   5,
+				CreatedAt:   time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+				UpdatedAt:   time.Date(2024, 6, 7, 8, 9, 10, 0, time.UTC),
 			},
 		},
 		"should handle vault with empty fields": {
@@ -60,11 +67,17 @@ func TestToConnectVault(t *testing.T) {
 				ID:          "vault1",
 				Name:        "Test Vault",
 				Description: "Test Description",
+				ItemCount:   5,
+				CreatedAt:   time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+				UpdatedAt:   time.Date(2024, 6, 7, 8, 9, 10, 0, time.UTC),
 			},
 			expected: &connect.Vault{
 				ID:          "vault1",
 				Name:        "Test Vault",
 				Description: "Test Description",
+				Items:       5,
+				CreatedAt:   time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+				UpdatedAt:   time.Date(2024, 6, 7, 8, 9, 10, 0, time.UTC),
 			},
 		},
 		"should handle vault with empty fields": {
@@ -98,14 +111,20 @@ func TestFromSDKVault(t *testing.T) {
 	}{
 		"should convert complete vault": {
 			input: &sdk.VaultOverview{
-				ID:          "vault1",
-				Title:       "Test Vault",
-				Description: "Test Description",
+				ID:              "vault1",
+				Title:           "Test Vault",
+				Description:     "Test Description",
+				ActiveItemCount: 5,
+				CreatedAt:       time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+				UpdatedAt:       time.Date(2024, 6, 7, 8, 9, 10, 0, time.UTC),
 			},
 			expected: &Vault{
 				ID:          "vault1",
 				Name:        "Test Vault",
 				Description: "Test Description",
+				ItemCount:   5,
+				CreatedAt:   time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+				UpdatedAt:   time.Date(2024, 6, 7, 8, 9, 10, 0, time.UTC),
 			},
 		},
 		"should handle vault with empty fields": {
@@ -132,3 +151,43 @@ func TestFromSDKVault(t *testing.T) {
 		})
 	}
 }
+
+func TestFromSDKFullVault(t *testing.T) {
+	tests := map[string]struct {
+		input    *sdk.Vault
+		expected *Vault
+	}{
+		"should convert complete vault": {
+			input: &sdk.Vault{
+				ID:              "vault1",
+				Title:           "Test Vault",
+				Description:     "Test Description",
+				ActiveItemCount: 3,
+			},
+			expected: &Vault{
+				ID:          "vault1",
+				Name:        "Test Vault",
+				Description: "Test Description",
+				ItemCount:   3,
+			},
+		},
+		"should handle vault with empty fields": {
+			input: &sdk.Vault{
+				ID: "vault1",
+			},
+			expected: &Vault{
+				ID: "vault1",
+			},
+		},
+	}
+
+	for description, test := range tests {
+		t.Run(description, func(t *testing.T) {
+			vault := &Vault{}
+			vault.FromSDKFullVault(test.input)
+			if !reflect.DeepEqual(vault, test.expected) {
+				t.Errorf("Expected %+v, got %+v", test.expected, vault)
+			}
+		})
+	}
+}
@@ -0,0 +1,357 @@
+package onepassword
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/model"
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/util"
+)
+
+const (
+	defaultRateLimitMaxWait = 5 * time.Minute
+	rateLimitBaseDelay      = time.Second
+	rateLimitMaxDelay       = time.Minute
+)
+
+// RateLimitConfig limits the requests made to 1Password. Limits that aren't set don't apply.
+type RateLimitConfig struct {
+	// RequestsPerMinute is the rate at which requests are started, allowing bursts of up to Burst requests.
+	RequestsPerMinute int
+	Burst             int
+	// MaxConcurrentRequests is the number of requests that can run at the same time.
+	MaxConcurrentRequests int
+	// MaxWait is how long requests rate limited by 1Password are retried before failing. Defaults to 5 minutes.
+	MaxWait time.Duration
+}
+
+// rateLimitedClient limits the requests made by the wrapped client. Requests rate limited by 1Password
+// are retried and reported to the RateLimitRecorder of the context instead of failing.
+type rateLimitedClient struct {
+	client  Client
+	bucket  *tokenBucket
+	slots   chan struct{}
+	maxWait time.Duration
+}
+
+var _ Client = &rateLimitedClient{}
+
+func newRateLimitedClient(client Client, config RateLimitConfig) *rateLimitedClient {
+	c := &rateLimitedClient{
+		client:  client,
+		maxWait: config.MaxWait,
+	}
+	if config.RequestsPerMinute > 0 {
+		c.bucket = newTokenBucket(float64(config.RequestsPerMinute)/60, max(config.Burst, 1))
+	}
+	if config.MaxConcurrentRequests > 0 {
+		c.slots = make(chan struct{}, config.MaxConcurrentRequests)
+	}
+	if c.maxWait <= 0 {
+		c.maxWait = defaultRateLimitMaxWait
+	}
+	return c
+}
+
+// do runs the operation once a request is allowed, retrying it while 1Password rate limits it.
+// The concurrency slot is only held while the operation runs, not while waiting to retry it.
+func (c *rateLimitedClient) do(ctx context.Context, operation func() error) error {
+	var waited time.Duration
+	for attempt := 0; ; attempt++ {
+		err := c.run(ctx, operation)
+		if !errors.Is(err, util.ErrRateLimited) {
+			return err
+		}
+
+		delay := rateLimitDelay(attempt)
+		if waited+delay > c.maxWait {
+			return err
+		}
+		tflog.Warn(ctx, "Request was rate limited by 1Password, retrying", map[string]any{"delay": delay.String(), "error": err.Error()})
+		if recorder, ok := ctx.Value(rateLimitRecorderKey{}).(*RateLimitRecorder); ok {
+			recorder.record(delay)
+		}
+		if err := util.Sleep(ctx, delay); err != nil {
+			return err
+		}
+		waited += delay
+	}
+}
+
+// run runs the operation once a concurrency slot is free and the rate allows another request.
+func (c *rateLimitedClient) run(ctx context.Context, operation func() error) error {
+	if c.slots != nil {
+		select {
+		case c.slots <- struct{}{}:
+			defer func() { <-c.slots }()
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	if c.bucket != nil {
+		if err := c.bucket.wait(ctx); err != nil {
+			return err
+		}
+	}
+	return operation()
+}
+
+// rateLimitDelay returns how long to wait before retrying a rate limited request.
+// Neither Connect nor the SDK report the Retry-After header, so the delay doubles with every attempt.
+func rateLimitDelay(attempt int) time.Duration {
+	delay := rateLimitBaseDelay
+	for i := 0; i < attempt && delay < rateLimitMaxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, rateLimitMaxDelay)
+}
+
+// tokenBucket allows requests at a steady rate with bursts of up to burst requests.
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64 // tokens per second
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rate float64, burst int) *tokenBucket {
+	return &tokenBucket{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// wait blocks until a token is available and takes it.
+func (b *tokenBucket) wait(ctx context.Context) error {
+	for {
+		b.mu.Lock()
+		now := time.Now()
+		b.tokens = min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+		b.last = now
+		if b.tokens >= 1 {
+			b.tokens--
+			b.mu.Unlock()
+			return nil
+		}
+		delay := time.Duration((1 - b.tokens) / b.rate * float64(time.Second))
+		b.mu.Unlock()
+
+		if err := util.Sleep(ctx, delay); err != nil {
+			return err
+		}
+	}
+}
+
+type rateLimitRecorderKey struct{}
+
+// RateLimitRecorder counts the requests 1Password rate limited while handling a context.
+type RateLimitRecorder struct {
+	mu     sync.Mutex
+	hits   int
+	waited time.Duration
+}
+
+// WithRateLimitRecorder returns a context that records the requests made with it which were rate limited.
+func WithRateLimitRecorder(ctx context.Context) (context.Context, *RateLimitRecorder) {
+	recorder := &RateLimitRecorder{}
+	return context.WithValue(ctx, rateLimitRecorderKey{}, recorder), recorder
+}
+
+func (r *RateLimitRecorder) record(waited time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.hits++
+	r.waited += waited
+}
+
+// Hits returns how often requests were rate limited and how long they waited in total before being retried.
+func (r *RateLimitRecorder) Hits() (int, time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.hits, r.waited
+}
+
+func (c *rateLimitedClient) GetVault(ctx context.Context, uuid string) (*model.Vault, error) {
+	var vault *model.Vault
+	err := c.do(ctx, func() (err error) {
+		vault, err = c.client.GetVault(ctx, uuid)
+		return err
+	})
+	return vault, err
+}
+
+func (c *rateLimitedClient) GetVaults(ctx context.Context) ([]model.Vault, error) {
+	var vaults []model.Vault
+	err := c.do(ctx, func() (err error) {
+		vaults, err = c.client.GetVaults(ctx)
+		return err
+	})
+	return vaults, err
+}
+
+func (c *rateLimitedClient) GetVaultsByTitle(ctx context.Context, title string) ([]model.Vault, error) {
+	var vaults []model.Vault
+	err := c.do(ctx, func() (err error) {
+		vaults, err = c.client.GetVaultsByTitle(ctx, title)
+		return err
+	})
+	return vaults, err
+}
+
+func (c *rateLimitedClient) CreateVault(ctx context.Context, vault *model.Vault) (*model.Vault, error) {
+	var created *model.Vault
+	err := c.do(ctx, func() (err error) {
+		created, err = c.client.CreateVault(ctx, vault)
+		return err
+	})
+	return created, err
+}
+
+func (c *rateLimitedClient) UpdateVault(ctx context.Context, vault *model.Vault) (*model.Vault, error) {
+	var updated *model.Vault
+	err := c.do(ctx, func() (err error) {
+		updated, err = c.client.UpdateVault(ctx, vault)
+		return err
+	})
+	return updated, err
+}
+
+func (c *rateLimitedClient) DeleteVault(ctx context.Context, vaultUuid string) error {
+	return c.do(ctx, func() error {
+		return c.client.DeleteVault(ctx, vaultUuid)
+	})
+}
+
+func (c *rateLimitedClient) GetVaultPermissions(ctx context.Context, vaultUuid string) ([]model.VaultAccess, error) {
+	var access []model.VaultAccess
+	err := c.do(ctx, func() (err error) {
+		access, err = c.client.GetVaultPermissions(ctx, vaultUuid)
+		return err
+	})
+	return access, err
+}
+
+func (c *rateLimitedClient) GrantVaultGroupPermissions(ctx context.Context, access model.VaultAccess) error {
+	return c.do(ctx, func() error {
+		return c.client.GrantVaultGroupPermissions(ctx, access)
+	})
+}
+
+func (c *rateLimitedClient) UpdateVaultGroupPermissions(ctx context.Context, access model.VaultAccess) error {
+	return c.do(ctx, func() error {
+		return c.client.UpdateVaultGroupPermissions(ctx, access)
+	})
+}
+
+func (c *rateLimitedClient) RevokeVaultGroupPermissions(ctx context.Context, vaultUuid, groupUuid string) error {
+	return c.do(ctx, func() error {
+		return c.client.RevokeVaultGroupPermissions(ctx, vaultUuid, groupUuid)
+	})
+}
+
+func (c *rateLimitedClient) GetItem(ctx context.Context, itemUuid, vaultUuid string) (*model.Item, error) {
+	var item *model.Item
+	err := c.do(ctx, func() (err error) {
+		item, err = c.client.GetItem(ctx, itemUuid, vaultUuid)
+		return err
+	})
+	return item, err
+}
+
+func (c *rateLimitedClient) GetItemByTitle(ctx context.Context, title string, vaultUuid string) (*model.Item, error) {
+	var item *model.Item
+	err := c.do(ctx, func() (err error) {
+		item, err = c.client.GetItemByTitle(ctx, title, vaultUuid)
+		return err
+	})
+	return item, err
+}
+
+func (c *rateLimitedClient) ListItems(ctx context.Context, vaultUuid string) ([]model.ItemOverview, error) {
+	var items []model.ItemOverview
+	err := c.do(ctx, func() (err error) {
+		items, err = c.client.ListItems(ctx, vaultUuid)
+		return err
+	})
+	return items, err
+}
+
+func (c *rateLimitedClient) CreateItem(ctx context.Context, item *model.Item, vaultUuid string) (*model.Item, error) {
+	var created *model.Item
+	err := c.do(ctx, func() (err error) {
+		created, err = c.client.CreateItem(ctx, item, vaultUuid)
+		return err
+	})
+	return created, err
+}
+
+func (c *rateLimitedClient) UpdateItem(ctx context.Context, item *model.Item, vaultUuid string) (*model.Item, error) {
+	var updated *model.Item
+	err := c.do(ctx, func() (err error) {
+		updated, err = c.client.UpdateItem(ctx, item, vaultUuid)
+		return err
+	})
+	return updated, err
+}
+
+func (c *rateLimitedClient) DeleteItem(ctx context.Context, item *model.Item, vaultUuid string) error {
+	return c.do(ctx, func() error {
+		return c.client.DeleteItem(ctx, item, vaultUuid)
+	})
+}
+
+func (c *rateLimitedClient) GetFileContent(ctx context.Context, file *model.ItemFile, itemUuid, vaultUuid string) ([]byte, error) {
+	var content []byte
+	err := c.do(ctx, func() (err error) {
+		content, err = c.client.GetFileContent(ctx, file, itemUuid, vaultUuid)
+		return err
+	})
+	return content, err
+}
+
+func (c *rateLimitedClient) UploadFile(ctx context.Context, item *model.Item, file *model.ItemFile, vaultUuid string) (*model.Item, error) {
+	var updated *model.Item
+	err := c.do(ctx, func() (err error) {
+		updated, err = c.client.UploadFile(ctx, item, file, vaultUuid)
+		return err
+	})
+	return updated, err
+}
+
+func (c *rateLimitedClient) DeleteFile(ctx context.Context, item *model.Item, file *model.ItemFile, vaultUuid string) (*model.Item, error) {
+	var updated *model.Item
+	err := c.do(ctx, func() (err error) {
+		updated, err = c.client.DeleteFile(ctx, item, file, vaultUuid)
+		return err
+	})
+	return updated, err
+}
+
+func (c *rateLimitedClient) ReplaceDocument(ctx context.Context, item *model.Item, file *model.ItemFile, vaultUuid string) (*model.Item, error) {
+	var updated *model.Item
+	err := c.do(ctx, func() (err error) {
+		updated, err = c.client.ReplaceDocument(ctx, item, file, vaultUuid)
+		return err
+	})
+	return updated, err
+}
+
+func (c *rateLimitedClient) GetEnvironmentVariables(ctx context.Context, environmentID string) ([]model.EnvironmentVariable, error) {
+	var variables []model.EnvironmentVariable
+	err := c.do(ctx, func() (err error) {
+		variables, err = c.client.GetEnvironmentVariables(ctx, environmentID)
+		return err
+	})
+	return variables, err
+}
+
+func (c *rateLimitedClient) RetryPolicy() util.RetryPolicy {
+	return c.client.RetryPolicy()
+}
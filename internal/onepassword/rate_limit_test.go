@@ -0,0 +1,199 @@
+package onepassword
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/model"
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/util"
+)
+
+// stubClient answers GetItem after the given delay, rate limiting the first rateLimited calls.
+type stubClient struct {
+	Client
+	delay       time.Duration
+	rateLimited int32
+	calls       atomic.Int32
+	running     atomic.Int32
+	maxRunning  atomic.Int32
+}
+
+func (c *stubClient) GetItem(_ context.Context, itemUuid, _ string) (*model.Item, error) {
+	call := c.calls.Add(1)
+	running := c.running.Add(1)
+	defer c.running.Add(-1)
+	for {
+		maxRunning := c.maxRunning.Load()
+		if running <= maxRunning || c.maxRunning.CompareAndSwap(maxRunning, running) {
+			break
+		}
+	}
+
+	time.Sleep(c.delay)
+	if call <= c.rateLimited {
+		return nil, util.NewError(util.ErrRateLimited, errors.New("rate limit exceeded"))
+	}
+	return &model.Item{ID: itemUuid}, nil
+}
+
+func TestRateLimitedClientMaxConcurrentRequests(t *testing.T) {
+	stub := &stubClient{delay: 20 * time.Millisecond}
+	client := newRateLimitedClient(stub, RateLimitConfig{MaxConcurrentRequests: 2})
+
+	var wg sync.WaitGroup
+	for range 6 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := client.GetItem(context.Background(), "item", "vault"); err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if maxRunning := stub.maxRunning.Load(); maxRunning != 2 {
+		t.Errorf("Expected at most 2 concurrent requests, got %d", maxRunning)
+	}
+}
+
+func TestRateLimitedClientReleasesSlotWhileWaiting(t *testing.T) {
+	stub := &stubClient{rateLimited: 1}
+	client := newRateLimitedClient(stub, RateLimitConfig{MaxConcurrentRequests: 1})
+
+	// The first request is rate limited and waits a second before it's retried
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		if _, err := client.GetItem(context.Background(), "item", "vault"); err != nil {
+			t.Errorf("Unexpected error: %v", err)
+		}
+	}()
+	for stub.calls.Load() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	start := time.Now()
+	if _, err := client.GetItem(context.Background(), "item", "vault"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("Expected the request to run while the other one waits, took %s", elapsed)
+	}
+	<-done
+}
+
+func TestNewClientDoesNotRetryRateLimitedRequestsTwice(t *testing.T) {
+	client, err := NewClient(context.Background(), ClientConfig{
+		FakeBackend: true,
+		Retry:       util.RetryPolicy{RetryRateLimited: true, RetryServerErrors: true},
+		RateLimit:   &RateLimitConfig{RequestsPerMinute: 60},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	policy := client.RetryPolicy()
+	if policy.RetryRateLimited {
+		t.Errorf("Expected the backend not to retry rate limited requests")
+	}
+	if !policy.RetryServerErrors {
+		t.Errorf("Expected the backend to keep retrying server errors")
+	}
+}
+
+func TestRateLimitedClientRequestsPerMinute(t *testing.T) {
+	stub := &stubClient{}
+	// 1200 requests per minute allow a request every 50ms after a burst of 2
+	client := newRateLimitedClient(stub, RateLimitConfig{RequestsPerMinute: 1200, Burst: 2})
+
+	start := time.Now()
+	for range 4 {
+		if _, err := client.GetItem(context.Background(), "item", "vault"); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+
+	if elapsed := time.Since(start); elapsed < 90*time.Millisecond {
+		t.Errorf("Expected requests to be spread over at least 100ms, took %s", elapsed)
+	}
+}
+
+func TestRateLimitedClientRetriesRateLimitedRequests(t *testing.T) {
+	tests := map[string]struct {
+		rateLimited   int32
+		maxWait       time.Duration
+		expectedErr   bool
+		expectedHits  int
+		expectedCalls int32
+	}{
+		"should retry rate limited requests and record them": {
+			rateLimited:   2,
+			maxWait:       time.Minute,
+			expectedHits:  2,
+			expectedCalls: 3,
+		},
+		"should fail when waiting longer than the maximum wait": {
+			rateLimited:   5,
+			maxWait:       2 * time.Second,
+			expectedErr:   true,
+			expectedHits:  1,
+			expectedCalls: 2,
+		},
+	}
+
+	for description, test := range tests {
+		t.Run(description, func(t *testing.T) {
+			stub := &stubClient{rateLimited: test.rateLimited}
+			client := newRateLimitedClient(stub, RateLimitConfig{MaxWait: test.maxWait})
+			ctx, recorder := WithRateLimitRecorder(context.Background())
+
+			_, err := client.GetItem(ctx, "item", "vault")
+
+			if (err != nil) != test.expectedErr {
+				t.Fatalf("Expected error %v, got %v", test.expectedErr, err)
+			}
+			if test.expectedErr && !errors.Is(err, util.ErrRateLimited) {
+				t.Errorf("Expected rate limited error, got %v", err)
+			}
+			if hits, _ := recorder.Hits(); hits != test.expectedHits {
+				t.Errorf("Expected %d rate limit hits, got %d", test.expectedHits, hits)
+			}
+			if calls := stub.calls.Load(); calls != test.expectedCalls {
+				t.Errorf("Expected %d calls, got %d", test.expectedCalls, calls)
+			}
+		})
+	}
+}
+
+func TestRateLimitDelay(t *testing.T) {
+	tests := map[string]struct {
+		attempt       int
+		expectedDelay time.Duration
+	}{
+		"should start with the base delay": {
+			attempt:       0,
+			expectedDelay: time.Second,
+		},
+		"should double the delay with every attempt": {
+			attempt:       3,
+			expectedDelay: 8 * time.Second,
+		},
+		"should not exceed the maximum delay": {
+			attempt:       20,
+			expectedDelay: time.Minute,
+		},
+	}
+
+	for description, test := range tests {
+		t.Run(description, func(t *testing.T) {
+			if delay := rateLimitDelay(test.attempt); delay != test.expectedDelay {
+				t.Errorf("Expected delay %s, got %s", test.expectedDelay, delay)
+			}
+		})
+	}
+}
@@ -20,12 +20,13 @@ type SDKConfig struct {
 	ProviderUserAgent   string
 	ServiceAccountToken string
 	Account             string
+	Retry               util.RetryPolicy
 }
 
 func (c *Client) GetVault(ctx context.Context, uuid string) (*model.Vault, error) {
 	vault, err := c.sdkClient.Vaults().GetOverview(ctx, uuid)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get vault using sdk: %w", err)
+		return nil, fmt.Errorf("failed to get vault using sdk: %w", sdkError(err))
 	}
 
 	v := &model.Vault{}
@@ -34,25 +35,151 @@ func (c *Client) GetVault(ctx context.Context, uuid string) (*model.Vault, error
 	return v, nil
 }
 
-func (c *Client) GetVaultsByTitle(ctx context.Context, title string) ([]model.Vault, error) {
+func (c *Client) GetVaults(ctx context.Context) ([]model.Vault, error) {
 	decryptDetails := true
 	vaultList, err := c.sdkClient.Vaults().List(ctx, sdk.VaultListParams{DecryptDetails: &decryptDetails})
 	if err != nil {
-		return nil, fmt.Errorf("failed to get vaults using sdk: %w", err)
+		return nil, fmt.Errorf("failed to get vaults using sdk: %w", sdkError(err))
+	}
+
+	result := make([]model.Vault, len(vaultList))
+	for i, vault := range vaultList {
+		result[i].FromSDKVault(&vault)
+	}
+
+	return result, nil
+}
+
+func (c *Client) GetVaultsByTitle(ctx context.Context, title string) ([]model.Vault, error) {
+	vaults, err := c.GetVaults(ctx)
+	if err != nil {
+		return nil, err
 	}
 
 	var result []model.Vault
-	for _, vault := range vaultList {
-		if vault.Title == title {
-			var modelVault model.Vault
-			modelVault.FromSDKVault(&vault)
-			result = append(result, modelVault)
+	for _, vault := range vaults {
+		if vault.Name == title {
+			result = append(result, vault)
 		}
 	}
 
 	return result, nil
 }
 
+func (c *Client) CreateVault(ctx context.Context, vault *model.Vault) (*model.Vault, error) {
+	params := sdk.VaultCreateParams{
+		Title: vault.Name,
+	}
+	if vault.Description != "" {
+		params.Description = &vault.Description
+	}
+
+	var sdkVault sdk.Vault
+	err := util.RetryOnConflict(ctx, c.config.Retry, func() error {
+		var createErr error
+		sdkVault, createErr = c.sdkClient.Vaults().Create(ctx, params)
+		return sdkError(createErr)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create vault using sdk: %w", err)
+	}
+
+	v := &model.Vault{}
+	v.FromSDKFullVault(&sdkVault)
+	return v, nil
+}
+
+func (c *Client) UpdateVault(ctx context.Context, vault *model.Vault) (*model.Vault, error) {
+	params := sdk.VaultUpdateParams{
+		Title:       &vault.Name,
+		Description: &vault.Description,
+	}
+
+	var sdkVault sdk.Vault
+	err := util.RetryOnConflict(ctx, c.config.Retry, func() error {
+		var updateErr error
+		sdkVault, updateErr = c.sdkClient.Vaults().Update(ctx, vault.ID, params)
+		return sdkError(updateErr)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update vault using sdk: %w", err)
+	}
+
+	v := &model.Vault{}
+	v.FromSDKFullVault(&sdkVault)
+	return v, nil
+}
+
+func (c *Client) DeleteVault(ctx context.Context, vaultUuid string) error {
+	err := util.RetryOnConflict(ctx, c.config.Retry, func() error {
+		return sdkError(c.sdkClient.Vaults().Delete(ctx, vaultUuid))
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete vault using sdk: %w", err)
+	}
+
+	return nil
+}
+
+// GetVaultPermissions returns the users and groups that have access to the vault together with their permissions.
+func (c *Client) GetVaultPermissions(ctx context.Context, vaultUuid string) ([]model.VaultAccess, error) {
+	accessors := true
+	vault, err := c.sdkClient.Vaults().Get(ctx, vaultUuid, sdk.VaultGetParams{Accessors: &accessors})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get vault permissions using sdk: %w", sdkError(err))
+	}
+
+	result := make([]model.VaultAccess, len(vault.Access))
+	for i, access := range vault.Access {
+		result[i].FromSDKVaultAccess(&access)
+	}
+	return result, nil
+}
+
+func (c *Client) GrantVaultGroupPermissions(ctx context.Context, access model.VaultAccess) error {
+	err := util.RetryOnConflict(ctx, c.config.Retry, func() error {
+		return sdkError(c.sdkClient.Vaults().GrantGroupPermissions(ctx, access.VaultID, []sdk.GroupAccess{
+			{
+				GroupID:     access.AccessorID,
+				Permissions: access.Permissions,
+			},
+		}))
+	})
+	if err != nil {
+		return fmt.Errorf("failed to grant vault permissions using sdk: %w", err)
+	}
+
+	return nil
+}
+
+func (c *Client) UpdateVaultGroupPermissions(ctx context.Context, access model.VaultAccess) error {
+	err := util.RetryOnConflict(ctx, c.config.Retry, func() error {
+		return sdkError(c.sdkClient.Vaults().UpdateGroupPermissions(ctx, []sdk.GroupVaultAccess{
+			{
+				VaultID:     access.VaultID,
+				GroupID:     access.AccessorID,
+				Permissions: access.Permissions,
+			},
+		}))
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update vault permissions using sdk: %w", err)
+	}
+
+	return nil
+}
+
+func (c *Client) RevokeVaultGroupPermissions(ctx context.Context, vaultUuid, groupUuid string) error {
+	err := util.RetryOnConflict(ctx, c.config.Retry, func() error {
+		return sdkError(c.sdkClient.Vaults().RevokeGroupPermissions(ctx, vaultUuid, groupUuid))
+	})
+	if err != nil {
+		return fmt.Errorf("failed to revoke vault permissions using sdk: %w", err)
+	}
+
+	return nil
+}
+
 // GetItem looks up an item by UUID or by title.
 // If itemUuid is a valid UUID format, it attempts to fetch the item by UUID.
 // If itemUuid is not a valid UUID format, it treats the parameter as a title
@@ -68,7 +195,7 @@ func (c *Client) GetItem(ctx context.Context, itemUuid, vaultUuid string) (*mode
 		// Valid UUID, use GetItem directly
 		sdkItem, err := c.sdkClient.Items().Get(ctx, resolvedVaultUUID, itemUuid)
 		if err != nil {
-			return nil, fmt.Errorf("failed to get item using sdk: %w", err)
+			return nil, fmt.Errorf("failed to get item using sdk: %w", sdkError(err))
 		}
 
 		modelItem := &model.Item{}
@@ -92,7 +219,7 @@ func (c *Client) GetItemByTitle(ctx context.Context, title string, vaultUuid str
 
 	items, err := c.sdkClient.Items().List(ctx, resolvedVaultUUID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get item using sdk: %w", err)
+		return nil, fmt.Errorf("failed to get item using sdk: %w", sdkError(err))
 	}
 
 	var matchedID string
@@ -111,7 +238,7 @@ func (c *Client) GetItemByTitle(ctx context.Context, title string, vaultUuid str
 
 	sdkItem, err := c.sdkClient.Items().Get(ctx, resolvedVaultUUID, matchedID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get item using sdk: %w", err)
+		return nil, fmt.Errorf("failed to get item using sdk: %w", sdkError(err))
 	}
 
 	modelItem := &model.Item{}
@@ -123,18 +250,41 @@ func (c *Client) GetItemByTitle(ctx context.Context, title string, vaultUuid str
 	return modelItem, nil
 }
 
+func (c *Client) ListItems(ctx context.Context, vaultUuid string) ([]model.ItemOverview, error) {
+	// Resolve vault name to UUID if needed
+	resolvedVaultUUID, err := c.resolveVaultUUID(ctx, vaultUuid)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := c.sdkClient.Items().List(ctx, resolvedVaultUUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list items using sdk: %w", sdkError(err))
+	}
+
+	result := make([]model.ItemOverview, len(items))
+	for i, item := range items {
+		result[i].FromSDKItemOverview(&item)
+	}
+
+	return result, nil
+}
+
 func (c *Client) CreateItem(ctx context.Context, item *model.Item, vaultUuid string) (*model.Item, error) {
-	params := item.FromModelItemToSDKCreateParams()
+	params, err := item.FromModelItemToSDKCreateParams()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create item using sdk: %w", err)
+	}
 
 	if params.VaultID != vaultUuid {
 		return nil, fmt.Errorf("vault UUID mismatch: item has %s but %s was provided", params.VaultID, vaultUuid)
 	}
 
 	var sdkItem sdk.Item
-	err := util.RetryOnConflict(ctx, func() error {
+	err = util.RetryOnConflict(ctx, c.config.Retry, func() error {
 		var createErr error
 		sdkItem, createErr = c.sdkClient.Items().Create(ctx, params)
-		return createErr
+		return sdkError(createErr)
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to create item using sdk: %w", err)
@@ -151,10 +301,18 @@ func (c *Client) CreateItem(ctx context.Context, item *model.Item, vaultUuid str
 func (c *Client) UpdateItem(ctx context.Context, item *model.Item, vaultUuid string) (*model.Item, error) {
 	currentItem, err := c.sdkClient.Items().Get(ctx, vaultUuid, item.ID)
 	if err != nil {
-		return nil, err
+		return nil, sdkError(err)
 	}
 
-	params := item.FromModelItemToSDKCreateParams()
+	// Only overwrite the expected version of the item when one is given
+	if item.Version != 0 && int(currentItem.Version) != item.Version {
+		return nil, util.NewError(util.ErrConflict, fmt.Errorf("failed to update item using sdk: version conflict, item version is %d, expected %d", currentItem.Version, item.Version))
+	}
+
+	params, err := item.FromModelItemToSDKCreateParams()
+	if err != nil {
+		return nil, fmt.Errorf("failed to update item using sdk: %w", err)
+	}
 	currentItem.Title = params.Title
 	currentItem.Category = params.Category
 	currentItem.Fields = params.Fields
@@ -166,10 +324,10 @@ func (c *Client) UpdateItem(ctx context.Context, item *model.Item, vaultUuid str
 	}
 
 	var updatedItem sdk.Item
-	err = util.RetryOnConflict(ctx, func() error {
+	err = util.RetryOnConflict(ctx, c.config.Retry, func() error {
 		var updateErr error
 		updatedItem, updateErr = c.sdkClient.Items().Put(ctx, currentItem)
-		return updateErr
+		return sdkError(updateErr)
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to update item using sdk: %w", err)
@@ -185,8 +343,8 @@ func (c *Client) UpdateItem(ctx context.Context, item *model.Item, vaultUuid str
 }
 
 func (c *Client) DeleteItem(ctx context.Context, item *model.Item, vaultUuid string) error {
-	err := util.RetryOnConflict(ctx, func() error {
-		return c.sdkClient.Items().Delete(ctx, vaultUuid, item.ID)
+	err := util.RetryOnConflict(ctx, c.config.Retry, func() error {
+		return sdkError(c.sdkClient.Items().Delete(ctx, vaultUuid, item.ID))
 	})
 	if err != nil {
 		return fmt.Errorf("failed to delete item using sdk: %w", err)
@@ -204,17 +362,116 @@ func (c *Client) GetFileContent(ctx context.Context, file *model.ItemFile, itemU
 
 	content, err := c.sdkClient.Items().Files().Read(ctx, vaultUUID, itemUUID, fileAttributes)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read file using sdk: %w", err)
+		return nil, fmt.Errorf("failed to read file using sdk: %w", sdkError(err))
 	}
 
 	return content, nil
 }
 
+// UploadFile attaches a file to the section of the item given by file.SectionID.
+// The file is stored in the field with the ID file.FieldID.
+func (c *Client) UploadFile(ctx context.Context, item *model.Item, file *model.ItemFile, vaultUuid string) (*model.Item, error) {
+	content, err := file.Content()
+	if err != nil {
+		return nil, err
+	}
+
+	currentItem, err := c.sdkClient.Items().Get(ctx, vaultUuid, item.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get item using sdk: %w", sdkError(err))
+	}
+
+	var updatedItem sdk.Item
+	err = util.RetryOnConflict(ctx, c.config.Retry, func() error {
+		var attachErr error
+		updatedItem, attachErr = c.sdkClient.Items().Files().Attach(ctx, currentItem, sdk.FileCreateParams{
+			Name:      file.Name,
+			Content:   content,
+			SectionID: file.SectionID,
+			FieldID:   file.FieldID,
+		})
+		return sdkError(attachErr)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to upload file using sdk: %w", err)
+	}
+
+	modelItem := &model.Item{}
+	err = modelItem.FromSDKItemToModel(&updatedItem)
+	if err != nil {
+		return nil, fmt.Errorf("sdk.UploadFile failed to convert item using sdk: %w", err)
+	}
+	return modelItem, nil
+}
+
+// DeleteFile removes a file from the section of an item.
+func (c *Client) DeleteFile(ctx context.Context, item *model.Item, file *model.ItemFile, vaultUuid string) (*model.Item, error) {
+	currentItem, err := c.sdkClient.Items().Get(ctx, vaultUuid, item.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get item using sdk: %w", sdkError(err))
+	}
+
+	var updatedItem sdk.Item
+	err = util.RetryOnConflict(ctx, c.config.Retry, func() error {
+		var deleteErr error
+		updatedItem, deleteErr = c.sdkClient.Items().Files().Delete(ctx, currentItem, file.SectionID, file.FieldID)
+		return sdkError(deleteErr)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete file using sdk: %w", err)
+	}
+
+	modelItem := &model.Item{}
+	err = modelItem.FromSDKItemToModel(&updatedItem)
+	if err != nil {
+		return nil, fmt.Errorf("sdk.DeleteFile failed to convert item using sdk: %w", err)
+	}
+	return modelItem, nil
+}
+
+// ReplaceDocument replaces the file of a document item.
+func (c *Client) ReplaceDocument(ctx context.Context, item *model.Item, file *model.ItemFile, vaultUuid string) (*model.Item, error) {
+	content, err := file.Content()
+	if err != nil {
+		return nil, err
+	}
+
+	currentItem, err := c.sdkClient.Items().Get(ctx, vaultUuid, item.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get item using sdk: %w", sdkError(err))
+	}
+
+	var updatedItem sdk.Item
+	err = util.RetryOnConflict(ctx, c.config.Retry, func() error {
+		var replaceErr error
+		updatedItem, replaceErr = c.sdkClient.Items().Files().ReplaceDocument(ctx, currentItem, sdk.DocumentCreateParams{
+			Name:    file.Name,
+			Content: content,
+		})
+		return sdkError(replaceErr)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to replace document using sdk: %w", err)
+	}
+
+	modelItem := &model.Item{}
+	err = modelItem.FromSDKItemToModel(&updatedItem)
+	if err != nil {
+		return nil, fmt.Errorf("sdk.ReplaceDocument failed to convert item using sdk: %w", err)
+	}
+	return modelItem, nil
+}
+
+// RetryPolicy returns the retry policy the client was configured with.
+func (c *Client) RetryPolicy() util.RetryPolicy {
+	return c.config.Retry
+}
+
 // GetEnvironmentVariables reads environment variables from a 1Password Environment.
 func (c *Client) GetEnvironmentVariables(ctx context.Context, environmentID string) ([]model.EnvironmentVariable, error) {
 	res, err := c.sdkClient.Environments().GetVariables(ctx, environmentID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get environment variables using sdk: %w", err)
+		return nil, fmt.Errorf("failed to get environment variables using sdk: %w", sdkError(err))
 	}
 
 	result := make([]model.EnvironmentVariable, len(res.Variables))
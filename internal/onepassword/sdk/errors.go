@@ -0,0 +1,96 @@
+package sdk
+
+import (
+	"errors"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	sdk "github.com/1password/onepassword-sdk-go"
+
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/util"
+)
+
+// statusKinds maps the HTTP statuses reported in SDK error messages to the kind of error they are.
+var statusKinds = map[int]error{
+	http.StatusNotFound:            util.ErrNotFound,
+	http.StatusConflict:            util.ErrConflict,
+	http.StatusUnauthorized:        util.ErrUnauthorized,
+	http.StatusForbidden:           util.ErrUnauthorized,
+	http.StatusTooManyRequests:     util.ErrRateLimited,
+	http.StatusInternalServerError: util.ErrServerError,
+	http.StatusBadGateway:          util.ErrServerError,
+	http.StatusServiceUnavailable:  util.ErrServerError,
+	http.StatusGatewayTimeout:      util.ErrServerError,
+}
+
+var (
+	// statusPattern matches a status code reported as "status 404" or "status code: 404".
+	statusPattern = regexp.MustCompile(`\bstatus(?: code)?:? (\d{3})\b`)
+	// statusLinePattern matches a status code followed by words, such as "404 not found".
+	statusLinePattern = regexp.MustCompile(`\b(\d{3}) ([a-z][a-z ]*)`)
+	// quotedPattern matches the double quoted titles, labels and values in a message.
+	quotedPattern = regexp.MustCompile(`"[^"]*"`)
+)
+
+// messageKinds lists the phrases the SDK uses for errors it reports without a status.
+var messageKinds = []struct {
+	phrase string
+	kind   error
+}{
+	{"couldn't be found", util.ErrNotFound},
+	{"is not in an active state", util.ErrNotFound},
+	{"rate limit exceeded", util.ErrRateLimited},
+	// Conflicts aren't always reported with a status
+	{"conflict", util.ErrConflict},
+}
+
+// sdkError marks err with the kind of error it is. The SDK only has error types for rate limiting and expired
+// desktop sessions, so other errors are classified by the HTTP status or the phrase in their message.
+// Bare numbers aren't matched and phrases aren't matched in quoted text, as they can be part of the IDs and titles in the message.
+func sdkError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var rateLimitErr *sdk.RateLimitExceededError
+	if errors.As(err, &rateLimitErr) {
+		return util.NewError(util.ErrRateLimited, err)
+	}
+	var sessionErr *sdk.DesktopSessionExpiredError
+	if errors.As(err, &sessionErr) {
+		return util.NewError(util.ErrUnauthorized, err)
+	}
+
+	msg := strings.ToLower(err.Error())
+	if kind := statusKind(msg); kind != nil {
+		return util.NewError(kind, err)
+	}
+	unquoted := quotedPattern.ReplaceAllString(msg, `""`)
+	for _, m := range messageKinds {
+		if strings.Contains(unquoted, m.phrase) {
+			return util.NewError(m.kind, err)
+		}
+	}
+	return err
+}
+
+// statusKind returns the kind of error of the HTTP status in the lowercase message, reported either as a status
+// code or as a status line such as "404 not found". It returns nil when the message has no known status.
+func statusKind(msg string) error {
+	if match := statusPattern.FindStringSubmatch(msg); match != nil {
+		code, _ := strconv.Atoi(match[1])
+		if kind, ok := statusKinds[code]; ok {
+			return kind
+		}
+	}
+	for _, match := range statusLinePattern.FindAllStringSubmatch(msg, -1) {
+		code, _ := strconv.Atoi(match[1])
+		kind, ok := statusKinds[code]
+		if ok && strings.HasPrefix(match[2], strings.ToLower(http.StatusText(code))) {
+			return kind
+		}
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package sdk
+
+import (
+	"errors"
+	"testing"
+
+	sdk "github.com/1password/onepassword-sdk-go"
+
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/util"
+)
+
+func TestSDKError(t *testing.T) {
+	tests := map[string]struct {
+		err          error
+		expectedKind error
+	}{
+		"should classify missing items as not found": {
+			err:          errors.New("error resolving item: item couldn't be found"),
+			expectedKind: util.ErrNotFound,
+		},
+		"should classify archived items as not found": {
+			err:          errors.New("item is not in an active state"),
+			expectedKind: util.ErrNotFound,
+		},
+		"should classify conflicts": {
+			err:          errors.New("http error: 409 Conflict"),
+			expectedKind: util.ErrConflict,
+		},
+		"should classify conflicts without a status": {
+			err:          errors.New("failed to update item: Conflict, the item was changed since it was read"),
+			expectedKind: util.ErrConflict,
+		},
+		"should classify status codes": {
+			err:          errors.New("request failed with status 404"),
+			expectedKind: util.ErrNotFound,
+		},
+		"should classify forbidden requests as unauthorized": {
+			err:          errors.New("http error: 403 Forbidden"),
+			expectedKind: util.ErrUnauthorized,
+		},
+		"should classify server errors": {
+			err:          errors.New("http error: 503 Service Unavailable"),
+			expectedKind: util.ErrServerError,
+		},
+		"should classify expired desktop sessions as unauthorized": {
+			err:          &sdk.DesktopSessionExpiredError{},
+			expectedKind: util.ErrUnauthorized,
+		},
+		"should classify rate limit errors": {
+			err:          &sdk.RateLimitExceededError{},
+			expectedKind: util.ErrRateLimited,
+		},
+		"should keep other errors unclassified": {
+			err:          errors.New("invalid item category"),
+			expectedKind: nil,
+		},
+		"should not classify a title containing a status code": {
+			err:          errors.New(`invalid field "Error 404 page" in item "HTTP 409 handler"`),
+			expectedKind: nil,
+		},
+		"should not classify an ID containing a status code": {
+			err:          errors.New("invalid section ID 4042a9f1c429"),
+			expectedKind: nil,
+		},
+		"should not classify a quoted title containing not found or conflict": {
+			err:          errors.New(`invalid value for field "conflict resolution" of item "Page not found"`),
+			expectedKind: nil,
+		},
+		"should not classify a title containing unauthorized": {
+			err:          errors.New(`title "Unauthorized access runbook" is too long`),
+			expectedKind: nil,
+		},
+	}
+
+	for description, test := range tests {
+		t.Run(description, func(t *testing.T) {
+			err := sdkError(test.err)
+
+			if !errors.Is(err, test.err) {
+				t.Errorf("Expected error to wrap %v, got %v", test.err, err)
+			}
+			if err.Error() != test.err.Error() {
+				t.Errorf("Expected message %q, got %q", test.err.Error(), err.Error())
+			}
+			for _, kind := range []error{util.ErrNotFound, util.ErrConflict, util.ErrUnauthorized, util.ErrRateLimited, util.ErrServerError} {
+				if errors.Is(err, kind) != (kind == test.expectedKind) {
+					t.Errorf("Expected kind %v, got %v", test.expectedKind, err)
+				}
+			}
+		})
+	}
+}
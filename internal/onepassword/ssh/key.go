@@ -0,0 +1,108 @@
+package ssh
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/ssh"
+)
+
+type KeyAlgorithm string
+
+const (
+	KeyAlgorithmEd25519 KeyAlgorithm = "ed25519"
+	KeyAlgorithmRSA     KeyAlgorithm = "rsa"
+
+	// DefaultRSABits is the RSA key size used when no size is given.
+	DefaultRSABits = 4096
+)
+
+// RSABitSizes are the RSA key sizes supported by 1Password.
+var RSABitSizes = []int64{2048, 3072, 4096}
+
+// GeneratePrivateKey generates a new private key with the given algorithm and returns it PKCS#8 PEM encoded.
+// The bit size is only used for RSA keys; a bit size of 0 uses DefaultRSABits.
+func GeneratePrivateKey(algorithm KeyAlgorithm, bits int) (string, error) {
+	var key crypto.PrivateKey
+	var err error
+
+	switch algorithm {
+	case KeyAlgorithmEd25519:
+		_, key, err = ed25519.GenerateKey(rand.Reader)
+	case KeyAlgorithmRSA:
+		if bits == 0 {
+			bits = DefaultRSABits
+		}
+		key, err = rsa.GenerateKey(rand.Reader, bits)
+	default:
+		return "", fmt.Errorf("unsupported key algorithm %q", algorithm)
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to generate %s key: %w", algorithm, err)
+	}
+
+	return marshalPKCS8(key)
+}
+
+// NormalizePrivateKey converts a PKCS#1, PKCS#8 or OpenSSH PEM encoded private key to PKCS#8 PEM, the format 1Password stores SSH keys in.
+// Only unencrypted RSA and Ed25519 keys are supported.
+func NormalizePrivateKey(pemBytes []byte) (string, error) {
+	key, err := parsePrivateKey(pemBytes)
+	if err != nil {
+		return "", err
+	}
+
+	return marshalPKCS8(key)
+}
+
+// PublicKeyAndFingerprint returns the public key in authorized_keys format and its SHA256 fingerprint
+// for a PEM encoded private key.
+func PublicKeyAndFingerprint(pemBytes []byte) (string, string, error) {
+	key, err := parsePrivateKey(pemBytes)
+	if err != nil {
+		return "", "", err
+	}
+
+	signer, err := ssh.NewSignerFromKey(key)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to derive public key: %w", err)
+	}
+
+	publicKey := strings.TrimSuffix(string(ssh.MarshalAuthorizedKey(signer.PublicKey())), "\n")
+	return publicKey, ssh.FingerprintSHA256(signer.PublicKey()), nil
+}
+
+// parsePrivateKey parses a PEM encoded private key and returns it as an *rsa.PrivateKey or ed25519.PrivateKey.
+func parsePrivateKey(pemBytes []byte) (crypto.PrivateKey, error) {
+	key, err := ssh.ParseRawPrivateKey(pemBytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid private key: %w", err)
+	}
+
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		return k, nil
+	case ed25519.PrivateKey:
+		return k, nil
+	// OpenSSH encoded Ed25519 keys are returned as a pointer.
+	case *ed25519.PrivateKey:
+		return *k, nil
+	default:
+		return nil, fmt.Errorf("unsupported private key type %T, only RSA and Ed25519 keys are supported", key)
+	}
+}
+
+func marshalPKCS8(key crypto.PrivateKey) (string, error) {
+	pkcs8Bytes, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal private key to PKCS#8: %w", err)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: pkcs8Bytes})), nil
+}
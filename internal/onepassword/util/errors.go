@@ -0,0 +1,44 @@
+package util
+
+import "errors"
+
+// Kinds of errors returned by the 1Password backends. Check for them with errors.Is.
+var (
+	// ErrNotFound is returned when a vault, item, file or Environment doesn't exist.
+	ErrNotFound = errors.New("not found")
+	// ErrConflict is returned when an item or vault was changed concurrently.
+	ErrConflict = errors.New("conflict")
+	// ErrUnauthorized is returned when the credentials are invalid, expired or lack permissions.
+	ErrUnauthorized = errors.New("unauthorized")
+	// ErrRateLimited is returned when 1Password rejects a request because too many requests were made.
+	ErrRateLimited = errors.New("rate limited")
+	// ErrServerError is returned when 1Password fails to handle a request with a 5xx status code.
+	ErrServerError = errors.New("server error")
+	// ErrUnsupportedByBackend is returned when an operation isn't available with the configured backend.
+	ErrUnsupportedByBackend = errors.New("not supported by backend")
+	// ErrConditionNotMet is returned by operations passed to Retry404UntilCondition when the awaited condition isn't met yet.
+	ErrConditionNotMet = errors.New("condition not met")
+)
+
+// Error is an error returned by a 1Password backend together with its kind.
+// Its message is the message of the backend error.
+type Error struct {
+	kind error
+	err  error
+}
+
+// NewError marks err as an error of the given kind, one of the errors above. It returns nil when err is nil.
+func NewError(kind, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &Error{kind: kind, err: err}
+}
+
+func (e *Error) Error() string {
+	return e.err.Error()
+}
+
+func (e *Error) Unwrap() []error {
+	return []error{e.kind, e.err}
+}
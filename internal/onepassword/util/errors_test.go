@@ -0,0 +1,28 @@
+package util
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestNewError(t *testing.T) {
+	backendErr := errors.New("status 404: Invalid Item UUID")
+	err := fmt.Errorf("failed to get item using connect: %w", NewError(ErrNotFound, backendErr))
+
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected error to be ErrNotFound")
+	}
+	if !errors.Is(err, backendErr) {
+		t.Errorf("Expected error to wrap the backend error")
+	}
+	if errors.Is(err, ErrConflict) {
+		t.Errorf("Expected error not to be ErrConflict")
+	}
+	if err.Error() != "failed to get item using connect: status 404: Invalid Item UUID" {
+		t.Errorf("Expected the message of the backend error, got %q", err.Error())
+	}
+	if NewError(ErrNotFound, nil) != nil {
+		t.Errorf("Expected nil for nil error")
+	}
+}
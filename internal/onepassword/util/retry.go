@@ -2,69 +2,124 @@ package util
 
 import (
 	"context"
-	"fmt"
+	"errors"
 	"math/rand"
-	"strings"
+	"net"
+	"net/http"
 	"time"
+
+	connect "github.com/1Password/connect-sdk-go/onepassword"
 )
 
 const (
-	maxRetryAttempts = 5
+	defaultMaxAttempts = 5
+	defaultBaseDelay   = 100 * time.Millisecond
+	defaultMaxDelay    = 500 * time.Millisecond
 )
 
+// RetryPolicy configures how often and how long operations are retried.
+// Fields that aren't set fall back to the values of DefaultRetryPolicy.
+type RetryPolicy struct {
+	// MaxAttempts is the number of times an operation is tried, including the first attempt.
+	MaxAttempts int
+	// BaseDelay is the delay before the first retry. It doubles with every further retry, up to MaxDelay.
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+	// Timeout limits the total time spent on an operation and its retries. There is no limit when it's zero.
+	Timeout time.Duration
+	// RetryRateLimited, RetryServerErrors and RetryNetworkErrors retry rate limited requests, 5xx responses
+	// and network errors in addition to the errors retried by each operation.
+	RetryRateLimited   bool
+	RetryServerErrors  bool
+	RetryNetworkErrors bool
+}
+
+// DefaultRetryPolicy returns the policy used when the provider configuration doesn't set one.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: defaultMaxAttempts,
+		BaseDelay:   defaultBaseDelay,
+		MaxDelay:    defaultMaxDelay,
+	}
+}
+
+func (p RetryPolicy) withDefaults() RetryPolicy {
+	if p.MaxAttempts <= 0 {
+		p.MaxAttempts = defaultMaxAttempts
+	}
+	if p.BaseDelay <= 0 {
+		p.BaseDelay = defaultBaseDelay
+	}
+	if p.MaxDelay <= 0 {
+		p.MaxDelay = max(defaultMaxDelay, p.BaseDelay)
+	}
+	return p
+}
+
+// retryable reports whether err is one of the classes of errors the policy retries.
+func (p RetryPolicy) retryable(err error) bool {
+	var netErr net.Error
+	return (p.RetryRateLimited && errors.Is(err, ErrRateLimited)) ||
+		(p.RetryServerErrors && errors.Is(err, ErrServerError)) ||
+		(p.RetryNetworkErrors && errors.As(err, &netErr))
+}
+
 // retry retries an operation when it returns a retryable error
-func retry(ctx context.Context, operation func() error, errorKeywords []string, maxAttempts int) error {
-	var err error
+func retry(ctx context.Context, policy RetryPolicy, operation func() error, isRetryable func(error) bool) error {
+	policy = policy.withDefaults()
+
+	var deadline time.Time
+	if policy.Timeout > 0 {
+		deadline = time.Now().Add(policy.Timeout)
+	}
 
-	for attempt := range maxAttempts {
+	var err error
+	for attempt := range policy.MaxAttempts {
 		err = operation()
 		if err == nil {
 			return nil
 		}
 
-		// Check if error contains any of the error messages
-		errStr := err.Error()
-		isRetryable := false
-		for _, keyword := range errorKeywords {
-			if strings.Contains(errStr, keyword) {
-				// If the error contains a retryable keyword, set isRetryable to true
-				isRetryable = true
-				break
-			}
-		}
-
-		if !isRetryable {
+		if !isRetryable(err) && !policy.retryable(err) {
 			return err
 		}
 
 		// Don't sleep on the last attempt
-		if attempt < maxAttempts-1 {
-			if ctx.Err() != nil {
-				return ctx.Err()
+		if attempt < policy.MaxAttempts-1 {
+			delay := policy.backoff(attempt)
+			// Give up when the next attempt would start after the timeout
+			if !deadline.IsZero() && time.Now().Add(delay).After(deadline) {
+				return err
+			}
+			if sleepErr := Sleep(ctx, delay); sleepErr != nil {
+				return sleepErr
 			}
-			backoff := calculateBackoff(attempt)
-			time.Sleep(backoff)
 		}
 	}
 
 	return err
 }
 
-// RetryOnConflict retries an operation when it returns 409 Conflict errors
-func RetryOnConflict(ctx context.Context, operation func() error) error {
-	return retry(ctx, operation, []string{"409", "Conflict", "conflict"}, maxRetryAttempts)
+// RetryOnConflict retries an operation when it returns ErrConflict
+func RetryOnConflict(ctx context.Context, policy RetryPolicy, operation func() error) error {
+	return retry(ctx, policy, operation, func(err error) bool {
+		return errors.Is(err, ErrConflict)
+	})
 }
 
 // Retry500ForConnectDelete retries a delete operation when it returns 500 Something went wrong only for Connect.
 // This is because Connect returns 500 for conflicts.
 // This is temporary until Connect is fixed and starts to return 409 for conflicts.
-func Retry500ForConnectDelete(ctx context.Context, operation func() error) error {
-	return retry(ctx, operation, []string{"500", "Something went wrong"}, maxRetryAttempts)
+func Retry500ForConnectDelete(ctx context.Context, policy RetryPolicy, operation func() error) error {
+	return retry(ctx, policy, operation, func(err error) bool {
+		var connectErr *connect.Error
+		return errors.As(err, &connectErr) && connectErr.StatusCode == http.StatusInternalServerError
+	})
 }
 
-// Retry404UntilCondition retries an operation when it returns 404 Not Found errors
-func Retry404UntilCondition(ctx context.Context, operation func() (bool, error)) error {
-	return retry(ctx, func() error {
+// Retry404UntilCondition retries an operation when it returns ErrNotFound or ErrConditionNotMet
+func Retry404UntilCondition(ctx context.Context, policy RetryPolicy, operation func() (bool, error)) error {
+	return retry(ctx, policy, func() error {
 		done, opErr := operation()
 		if opErr != nil {
 			return opErr
@@ -73,23 +128,33 @@ func Retry404UntilCondition(ctx context.Context, operation func() (bool, error))
 			return nil
 		}
 
-		// Fallback: callers should return errors with "condition not met" when condition isn't satisfied
-		return fmt.Errorf("condition not met")
-	}, []string{"condition not met", "404", "not found"}, maxRetryAttempts)
+		// Fallback: callers should return errors wrapping ErrConditionNotMet when condition isn't satisfied
+		return ErrConditionNotMet
+	}, func(err error) bool {
+		return errors.Is(err, ErrNotFound) || errors.Is(err, ErrConditionNotMet)
+	})
 }
 
-// calculateBackoff calculates backoff with jitter
-func calculateBackoff(attempt int) time.Duration {
-	baseDelay := 100 * time.Millisecond
-	maxDelay := 500 * time.Millisecond
+// backoff calculates the delay before the next attempt with jitter.
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	exponentialDelay := p.BaseDelay
+	for i := 0; i < attempt && exponentialDelay < p.MaxDelay; i++ {
+		exponentialDelay *= 2
+	}
+	jitter := time.Duration(rand.Int63n(int64(p.BaseDelay)))
+
+	return min(exponentialDelay+jitter, p.MaxDelay)
+}
 
-	exponentialDelay := baseDelay * time.Duration(1<<uint(attempt))
-	jitter := time.Duration(rand.Int63n(int64(baseDelay)))
+// Sleep waits for the given duration and returns early with the error of the context when it's done.
+func Sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
 
-	delay := exponentialDelay + jitter
-	if delay > maxDelay {
-		delay = maxDelay
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
 	}
-
-	return delay
 }
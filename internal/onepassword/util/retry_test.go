@@ -4,8 +4,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net"
 	"strings"
 	"testing"
+	"time"
+
+	connect "github.com/1Password/connect-sdk-go/onepassword"
 )
 
 func TestRetry404UntilCondition(t *testing.T) {
@@ -27,7 +31,7 @@ func TestRetry404UntilCondition(t *testing.T) {
 				return func() (bool, error) {
 					attempt++
 					if attempt < 3 {
-						return false, errors.New("status 404: item not found")
+						return false, NewError(ErrNotFound, errors.New("status 404: item not found"))
 					}
 					return true, nil
 				}
@@ -37,7 +41,7 @@ func TestRetry404UntilCondition(t *testing.T) {
 		},
 		"should return 404 error after max retries": {
 			operation: func() (bool, error) {
-				return false, errors.New("status 404: item not found")
+				return false, NewError(ErrNotFound, errors.New("status 404: item not found"))
 			},
 			expectedErr:     "status 404: item not found",
 			expectedRetries: 5,
@@ -49,13 +53,20 @@ func TestRetry404UntilCondition(t *testing.T) {
 			expectedErr:     "status 500: internal server error",
 			expectedRetries: 1,
 		},
+		"should not retry unrelated errors mentioning not found": {
+			operation: func() (bool, error) {
+				return false, errors.New("vault name not found in configuration")
+			},
+			expectedErr:     "vault name not found in configuration",
+			expectedRetries: 1,
+		},
 		"should retry on condition not met": {
 			operation: func() func() (bool, error) {
 				attempt := 0
 				return func() (bool, error) {
 					attempt++
 					if attempt < 3 {
-						return false, fmt.Errorf("condition not met: item version does not match")
+						return false, fmt.Errorf("%w: item version does not match", ErrConditionNotMet)
 					}
 					return true, nil
 				}
@@ -65,7 +76,7 @@ func TestRetry404UntilCondition(t *testing.T) {
 		},
 		"should return condition not met error after max retries": {
 			operation: func() (bool, error) {
-				return false, fmt.Errorf("condition not met: item version does not match")
+				return false, fmt.Errorf("%w: item version does not match", ErrConditionNotMet)
 			},
 			expectedErr:     "condition not met: item version does not match",
 			expectedRetries: 5,
@@ -90,7 +101,7 @@ func TestRetry404UntilCondition(t *testing.T) {
 				return originalOp()
 			}
 
-			err := Retry404UntilCondition(context.Background(), operation)
+			err := Retry404UntilCondition(context.Background(), DefaultRetryPolicy(), operation)
 
 			// Check error
 			if test.expectedErr == "" {
@@ -136,7 +147,7 @@ func TestRetryOnConflict(t *testing.T) {
 				return func() error {
 					attempt++
 					if attempt < 3 {
-						return errors.New("status 409: Conflict")
+						return NewError(ErrConflict, errors.New("status 409: Conflict"))
 					}
 					return nil
 				}
@@ -148,7 +159,7 @@ func TestRetryOnConflict(t *testing.T) {
 		},
 		"should return generic error after max retries on 409": {
 			operation: func() error {
-				return errors.New("status 409: Conflict")
+				return NewError(ErrConflict, errors.New("status 409: Conflict"))
 			},
 			refreshVersion:  nil,
 			expectedErr:     "status 409: Conflict",
@@ -165,13 +176,13 @@ func TestRetryOnConflict(t *testing.T) {
 			expectedRefresh: 0,
 		},
 
-		"should retry on different 409 error formats": {
+		"should retry on wrapped conflict errors": {
 			operation: func() func() error {
 				attempt := 0
 				return func() error {
 					attempt++
 					if attempt < 2 {
-						return errors.New("conflict error")
+						return fmt.Errorf("failed to update item: %w", NewError(ErrConflict, errors.New("conflict error")))
 					}
 					return nil
 				}
@@ -181,6 +192,15 @@ func TestRetryOnConflict(t *testing.T) {
 			expectedRetries: 2,
 			expectedRefresh: 0,
 		},
+		"should not retry unrelated errors mentioning conflict": {
+			operation: func() error {
+				return errors.New("conflict_detection must be a boolean")
+			},
+			refreshVersion:  nil,
+			expectedErr:     "conflict_detection must be a boolean",
+			expectedRetries: 1,
+			expectedRefresh: 0,
+		},
 	}
 
 	for description, test := range tests {
@@ -195,7 +215,7 @@ func TestRetryOnConflict(t *testing.T) {
 				return originalOp()
 			}
 
-			err := RetryOnConflict(context.Background(), operation)
+			err := RetryOnConflict(context.Background(), DefaultRetryPolicy(), operation)
 
 			// Check error
 			if test.expectedErr == "" {
@@ -222,3 +242,124 @@ func TestRetryOnConflict(t *testing.T) {
 		})
 	}
 }
+
+func TestRetry500ForConnectDelete(t *testing.T) {
+	tests := map[string]struct {
+		err             error
+		expectedRetries int
+	}{
+		"should retry Connect internal server errors": {
+			err:             &connect.Error{StatusCode: 500, Message: "Something went wrong"},
+			expectedRetries: 5,
+		},
+		"should not retry other Connect errors": {
+			err:             &connect.Error{StatusCode: 400, Message: "Invalid request"},
+			expectedRetries: 1,
+		},
+		"should not retry errors mentioning 500": {
+			err:             errors.New("item has 500 fields"),
+			expectedRetries: 1,
+		},
+	}
+
+	for description, test := range tests {
+		t.Run(description, func(t *testing.T) {
+			attempts := 0
+			err := Retry500ForConnectDelete(context.Background(), DefaultRetryPolicy(), func() error {
+				attempts++
+				return test.err
+			})
+
+			if !errors.Is(err, test.err) {
+				t.Errorf("Expected error %v, got %v", test.err, err)
+			}
+			if attempts != test.expectedRetries {
+				t.Errorf("Expected %d retry attempts, got %d", test.expectedRetries, attempts)
+			}
+		})
+	}
+}
+
+func TestRetryPolicy(t *testing.T) {
+	fastPolicy := RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond}
+	rateLimitErr := NewError(ErrRateLimited, errors.New("status 429: Too Many Requests"))
+	serverErr := NewError(ErrServerError, errors.New("status 503: Service Unavailable"))
+	networkErr := &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}
+
+	tests := map[string]struct {
+		policy          RetryPolicy
+		err             error
+		expectedRetries int
+	}{
+		"should use the configured number of attempts": {
+			policy:          RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond},
+			err:             NewError(ErrConflict, errors.New("status 409: Conflict")),
+			expectedRetries: 2,
+		},
+		"should not retry rate limited requests by default": {
+			policy:          fastPolicy,
+			err:             rateLimitErr,
+			expectedRetries: 1,
+		},
+		"should retry rate limited requests when enabled": {
+			policy:          RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond, RetryRateLimited: true},
+			err:             rateLimitErr,
+			expectedRetries: 3,
+		},
+		"should retry server errors when enabled": {
+			policy:          RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond, RetryServerErrors: true},
+			err:             serverErr,
+			expectedRetries: 3,
+		},
+		"should not retry server errors when only network errors are enabled": {
+			policy:          RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond, RetryNetworkErrors: true},
+			err:             serverErr,
+			expectedRetries: 1,
+		},
+		"should retry network errors when enabled": {
+			policy:          RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond, RetryNetworkErrors: true},
+			err:             fmt.Errorf("failed to get vault using connect: %w", networkErr),
+			expectedRetries: 3,
+		},
+		"should stop retrying after the timeout": {
+			policy:          RetryPolicy{MaxAttempts: 10, BaseDelay: 50 * time.Millisecond, Timeout: 120 * time.Millisecond},
+			err:             NewError(ErrConflict, errors.New("status 409: Conflict")),
+			expectedRetries: 2,
+		},
+	}
+
+	for description, test := range tests {
+		t.Run(description, func(t *testing.T) {
+			attempts := 0
+			err := RetryOnConflict(context.Background(), test.policy, func() error {
+				attempts++
+				return test.err
+			})
+
+			if !errors.Is(err, test.err) {
+				t.Errorf("Expected error %v, got %v", test.err, err)
+			}
+			if attempts != test.expectedRetries {
+				t.Errorf("Expected %d retry attempts, got %d", test.expectedRetries, attempts)
+			}
+		})
+	}
+}
+
+func TestRetryContextCancellation(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	policy := RetryPolicy{MaxAttempts: 5, BaseDelay: time.Hour}
+
+	start := time.Now()
+	err := RetryOnConflict(ctx, policy, func() error {
+		return NewError(ErrConflict, errors.New("status 409: Conflict"))
+	})
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected context deadline exceeded, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Expected sleep to stop when the context is done, waited %s", elapsed)
+	}
+}
@@ -0,0 +1,87 @@
+package util
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+const secretReferencePrefix = "op://"
+
+// secretReferenceAttributes are the values accepted by the `attribute` query parameter of a secret reference.
+var secretReferenceAttributes = []string{"type", "value", "id", "purpose", "otp", "totp", "content", "size", "name"}
+
+// SecretReference is a parsed 1Password secret reference in the format
+// op://<vault>/<item>/[<section>/]<field>[?attribute=<attribute>&ssh-format=<format>].
+type SecretReference struct {
+	Vault     string
+	Item      string
+	Section   string
+	Field     string
+	Attribute string
+	SSHFormat string
+}
+
+// ParseSecretReference parses a 1Password secret reference.
+// Vault, item, section and field may be names or IDs; percent-encoded characters are decoded.
+func ParseSecretReference(reference string) (*SecretReference, error) {
+	if !strings.HasPrefix(reference, secretReferencePrefix) {
+		return nil, fmt.Errorf("secret reference %q must start with %q", reference, secretReferencePrefix)
+	}
+
+	rest := strings.TrimPrefix(reference, secretReferencePrefix)
+	path, rawQuery, _ := strings.Cut(rest, "?")
+
+	segments := strings.Split(path, "/")
+	if len(segments) != 3 && len(segments) != 4 {
+		return nil, fmt.Errorf("secret reference %q must have the format op://<vault>/<item>/[<section>/]<field>", reference)
+	}
+	for i, segment := range segments {
+		if segment == "" {
+			return nil, fmt.Errorf("secret reference %q contains an empty path segment", reference)
+		}
+		decoded, err := url.PathUnescape(segment)
+		if err != nil {
+			return nil, fmt.Errorf("secret reference %q contains an invalid escape sequence: %w", reference, err)
+		}
+		segments[i] = decoded
+	}
+
+	ref := &SecretReference{
+		Vault: segments[0],
+		Item:  segments[1],
+		Field: segments[len(segments)-1],
+	}
+	if len(segments) == 4 {
+		ref.Section = segments[2]
+	}
+
+	query, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return nil, fmt.Errorf("secret reference %q contains an invalid query: %w", reference, err)
+	}
+	for key, values := range query {
+		if len(values) != 1 {
+			return nil, fmt.Errorf("secret reference %q must set query parameter %q at most once", reference, key)
+		}
+		value := strings.ToLower(values[0])
+
+		switch key {
+		case "attribute":
+			if !slices.Contains(secretReferenceAttributes, value) {
+				return nil, fmt.Errorf("secret reference %q has unsupported attribute %q, expected one of %q", reference, value, secretReferenceAttributes)
+			}
+			ref.Attribute = value
+		case "ssh-format":
+			if value != "openssh" {
+				return nil, fmt.Errorf("secret reference %q has unsupported ssh-format %q, expected \"openssh\"", reference, value)
+			}
+			ref.SSHFormat = value
+		default:
+			return nil, fmt.Errorf("secret reference %q has unsupported query parameter %q", reference, key)
+		}
+	}
+
+	return ref, nil
+}
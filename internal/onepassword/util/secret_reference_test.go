@@ -0,0 +1,104 @@
+package util
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseSecretReference(t *testing.T) {
+	tests := map[string]struct {
+		reference   string
+		expected    *SecretReference
+		expectError bool
+	}{
+		"should parse reference without section": {
+			reference: "op://Production/Database/password",
+			expected: &SecretReference{
+				Vault: "Production",
+				Item:  "Database",
+				Field: "password",
+			},
+		},
+		"should parse reference with section": {
+			reference: "op://Production/Database/admin/password",
+			expected: &SecretReference{
+				Vault:   "Production",
+				Item:    "Database",
+				Section: "admin",
+				Field:   "password",
+			},
+		},
+		"should decode escaped names": {
+			reference: "op://My%20Vault/My%20Item/one-time%20password",
+			expected: &SecretReference{
+				Vault: "My Vault",
+				Item:  "My Item",
+				Field: "one-time password",
+			},
+		},
+		"should parse attribute query": {
+			reference: "op://vault/item/one-time password?attribute=OTP",
+			expected: &SecretReference{
+				Vault:     "vault",
+				Item:      "item",
+				Field:     "one-time password",
+				Attribute: "otp",
+			},
+		},
+		"should parse ssh-format query": {
+			reference: "op://vault/ssh key/private key?ssh-format=openssh",
+			expected: &SecretReference{
+				Vault:     "vault",
+				Item:      "ssh key",
+				Field:     "private key",
+				SSHFormat: "openssh",
+			},
+		},
+		"should error without op:// prefix": {
+			reference:   "vault/item/field",
+			expectError: true,
+		},
+		"should error with too few segments": {
+			reference:   "op://vault/item",
+			expectError: true,
+		},
+		"should error with too many segments": {
+			reference:   "op://vault/item/section/field/extra",
+			expectError: true,
+		},
+		"should error with empty segment": {
+			reference:   "op://vault//field",
+			expectError: true,
+		},
+		"should error on unsupported attribute": {
+			reference:   "op://vault/item/field?attribute=secret",
+			expectError: true,
+		},
+		"should error on unsupported ssh-format": {
+			reference:   "op://vault/item/private key?ssh-format=pkcs8",
+			expectError: true,
+		},
+		"should error on unknown query parameter": {
+			reference:   "op://vault/item/field?foo=bar",
+			expectError: true,
+		},
+	}
+
+	for description, test := range tests {
+		t.Run(description, func(t *testing.T) {
+			actual, err := ParseSecretReference(test.reference)
+			if test.expectError {
+				if err == nil {
+					t.Errorf("Expected error for %s, got nil", test.reference)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error for %s: %v", test.reference, err)
+			}
+			if !reflect.DeepEqual(actual, test.expected) {
+				t.Errorf("Expected %+v, got %+v", test.expected, actual)
+			}
+		})
+	}
+}
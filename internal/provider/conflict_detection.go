@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword"
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/model"
+)
+
+// checkItemConflict returns an error when the version of the item in 1Password differs from the version in state,
+// listing the fields that were changed remotely. Items without a version in state are not checked.
+func checkItemConflict(ctx context.Context, client onepassword.Client, state OnePasswordItemResourceModel) diag.Diagnostics {
+	if state.Version.IsNull() || state.Version.IsUnknown() {
+		return nil
+	}
+
+	vaultUUID, itemUUID := vaultAndItemUUID(state.ID.ValueString())
+	currentItem, err := client.GetItem(ctx, itemUUID, vaultUUID)
+	if err != nil {
+		return diag.Diagnostics{diag.NewErrorDiagnostic(
+			"1Password Item read error",
+			fmt.Sprintf("Could not read item '%s' from vault '%s' to check for conflicting changes, got error: %s", itemUUID, vaultUUID, err),
+		)}
+	}
+
+	stateVersion := int(state.Version.ValueInt64())
+	if currentItem.Version == stateVersion {
+		return nil
+	}
+
+	previousItem, diagnostics := stateToModel(ctx, state)
+	if diagnostics.HasError() {
+		return diagnostics
+	}
+
+	changes := remoteItemChanges(previousItem, currentItem)
+	if len(changes) == 0 {
+		changes = []string{"no managed fields"}
+	}
+
+	return diag.Diagnostics{diag.NewErrorDiagnostic(
+		"1Password Item conflict",
+		fmt.Sprintf("Item '%s' in vault '%s' was modified outside of Terraform: its version is %d, but version %d was last read into state.\n\n"+
+			"Changed remotely: %s.\n\n"+
+			"Run `terraform apply -refresh-only` to accept the remote changes or `terraform apply` after a refresh to overwrite them.",
+			itemUUID, vaultUUID, currentItem.Version, stateVersion, strings.Join(changes, ", ")),
+	)}
+}
+
+// remoteItemChanges returns the names of the title, tags, websites and fields that differ between the item
+// as it was last read into state and its current version. Values are never included, as they may be secret.
+// Fields without a value in state, such as write-only fields, are ignored.
+func remoteItemChanges(previous, current *model.Item) []string {
+	var changes []string
+
+	if previous.Title != current.Title {
+		changes = append(changes, "title")
+	}
+	if !equalStringSets(previous.Tags, current.Tags) {
+		changes = append(changes, "tags")
+	}
+
+	previousURLs := make([]string, 0, len(previous.URLs))
+	for _, u := range previous.URLs {
+		if u.URL != "" {
+			previousURLs = append(previousURLs, u.URL)
+		}
+	}
+	currentURLs := make([]string, 0, len(current.URLs))
+	for _, u := range current.URLs {
+		currentURLs = append(currentURLs, u.URL)
+	}
+	if !equalStringSets(previousURLs, currentURLs) {
+		changes = append(changes, "websites")
+	}
+
+	currentFields := make(map[string]model.ItemField, len(current.Fields))
+	for _, f := range current.Fields {
+		currentFields[conflictFieldKey(f)] = f
+	}
+
+	previousFields := make(map[string]bool, len(previous.Fields))
+	for _, f := range previous.Fields {
+		key := conflictFieldKey(f)
+		previousFields[key] = true
+		if f.Value == "" {
+			continue
+		}
+		currentField, ok := currentFields[key]
+		if !ok {
+			changes = append(changes, key+" (removed)")
+		} else if currentField.Value != f.Value {
+			changes = append(changes, key)
+		}
+	}
+
+	// Only report fields added to sections, as built-in fields that aren't managed are always present
+	var added []string
+	for key, f := range currentFields {
+		if !previousFields[key] && f.SectionID != "" && f.Value != "" {
+			added = append(added, key+" (added)")
+		}
+	}
+	sort.Strings(added)
+
+	return append(changes, added...)
+}
+
+// conflictFieldKey returns a readable name of a field, using its label for fields in sections and its ID otherwise.
+func conflictFieldKey(f model.ItemField) string {
+	if f.SectionID != "" || f.SectionLabel != "" {
+		return fmt.Sprintf("section %q field %q", f.SectionLabel, f.Label)
+	}
+	if f.Purpose == model.FieldPurposeNotes {
+		return "notes"
+	}
+	if f.ID != "" {
+		return fmt.Sprintf("field %q", f.ID)
+	}
+	return fmt.Sprintf("field %q", f.Label)
+}
+
+func equalStringSets(a, b []string) bool {
+	sortedA := append([]string{}, a...)
+	sortedB := append([]string{}, b...)
+	sort.Strings(sortedA)
+	sort.Strings(sortedB)
+	return reflect.DeepEqual(sortedA, sortedB)
+}
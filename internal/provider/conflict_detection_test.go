@@ -0,0 +1,150 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword"
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/model"
+)
+
+// itemClient returns the same item for every read.
+type itemClient struct {
+	onepassword.Client
+	item *model.Item
+}
+
+func (c *itemClient) GetItem(_ context.Context, _, _ string) (*model.Item, error) {
+	return c.item, nil
+}
+
+func TestRemoteItemChanges(t *testing.T) {
+	previous := &model.Item{
+		Title: "Database",
+		Tags:  []string{"prod", "db"},
+		URLs:  []model.ItemURL{{URL: "https://example.com", Primary: true}},
+		Fields: []model.ItemField{
+			{ID: "username", Label: "username", Purpose: model.FieldPurposeUsername, Value: "admin"},
+			{ID: "password", Label: "password", Purpose: model.FieldPurposePassword, Value: ""},
+			{ID: "notesPlain", Label: "notesPlain", Purpose: model.FieldPurposeNotes, Value: "notes"},
+			{ID: "host", Label: "host", SectionID: "s1", SectionLabel: "connection", Value: "db.example.com"},
+		},
+	}
+
+	tests := map[string]struct {
+		current  *model.Item
+		expected []string
+	}{
+		"should report no changes for same item": {
+			current: &model.Item{
+				Title: "Database",
+				Tags:  []string{"db", "prod"},
+				URLs:  []model.ItemURL{{URL: "https://example.com", Primary: true}},
+				Fields: []model.ItemField{
+					{ID: "username", Label: "username", Purpose: model.FieldPurposeUsername, Value: "admin"},
+					{ID: "password", Label: "password", Purpose: model.FieldPurposePassword, Value: "secret"},
+					{ID: "notesPlain", Label: "notesPlain", Purpose: model.FieldPurposeNotes, Value: "notes"},
+					{ID: "host", Label: "host", SectionID: "s1", SectionLabel: "connection", Value: "db.example.com"},
+				},
+			},
+		},
+		"should report changed, removed and added fields": {
+			current: &model.Item{
+				Title: "Renamed",
+				Tags:  []string{"db"},
+				URLs:  []model.ItemURL{{URL: "https://example.org", Primary: true}},
+				Fields: []model.ItemField{
+					{ID: "username", Label: "username", Purpose: model.FieldPurposeUsername, Value: "root"},
+					{ID: "password", Label: "password", Purpose: model.FieldPurposePassword, Value: "secret"},
+					{ID: "notesPlain", Label: "notesPlain", Purpose: model.FieldPurposeNotes, Value: "notes"},
+					{ID: "port", Label: "port", SectionID: "s1", SectionLabel: "connection", Value: "5432"},
+				},
+			},
+			expected: []string{
+				"title",
+				"tags",
+				"websites",
+				`field "username"`,
+				`section "connection" field "host" (removed)`,
+				`section "connection" field "port" (added)`,
+			},
+		},
+	}
+
+	for description, test := range tests {
+		t.Run(description, func(t *testing.T) {
+			actual := remoteItemChanges(previous, test.current)
+			if !reflect.DeepEqual(actual, test.expected) {
+				t.Errorf("Expected %v, got %v", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestCheckItemConflict(t *testing.T) {
+	state := OnePasswordItemResourceModel{
+		ID:       types.StringValue("vaults/vault1/items/item1"),
+		UUID:     types.StringValue("item1"),
+		Vault:    types.StringValue("vault1"),
+		Version:  types.Int64Value(2),
+		Category: types.StringValue("password"),
+		Title:    types.StringValue("Token"),
+		Password: types.StringValue("secret"),
+		Tags:     types.ListNull(types.StringType),
+	}
+
+	tests := map[string]struct {
+		state         OnePasswordItemResourceModel
+		current       *model.Item
+		expectedError string
+	}{
+		"should pass when version is unchanged": {
+			state:   state,
+			current: &model.Item{ID: "item1", Version: 2, Title: "Token"},
+		},
+		"should pass without version in state": {
+			state: func() OnePasswordItemResourceModel {
+				s := state
+				s.Version = types.Int64Null()
+				return s
+			}(),
+			current: &model.Item{ID: "item1", Version: 5, Title: "Token"},
+		},
+		"should fail when version has moved": {
+			state: state,
+			current: &model.Item{
+				ID:      "item1",
+				Version: 3,
+				Title:   "Token",
+				Fields: []model.ItemField{
+					{ID: "password", Label: "password", Purpose: model.FieldPurposePassword, Value: "rotated"},
+				},
+			},
+			expectedError: `its version is 3, but version 2 was last read into state.
+
+Changed remotely: field "password".`,
+		},
+	}
+
+	for description, test := range tests {
+		t.Run(description, func(t *testing.T) {
+			diagnostics := checkItemConflict(context.Background(), &itemClient{item: test.current}, test.state)
+			if test.expectedError == "" {
+				if diagnostics.HasError() {
+					t.Errorf("Expected no error, got %v", diagnostics)
+				}
+				return
+			}
+			if !diagnostics.HasError() {
+				t.Fatalf("Expected error containing %q, got none", test.expectedError)
+			}
+			if detail := diagnostics.Errors()[0].Detail(); !strings.Contains(detail, test.expectedError) {
+				t.Errorf("Expected error containing %q, got %q", test.expectedError, detail)
+			}
+		})
+	}
+}
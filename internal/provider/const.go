@@ -4,6 +4,7 @@ import (
 	"strings"
 
 	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/model"
+	opssh "github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/ssh"
 )
 
 const (
@@ -15,32 +16,62 @@ const (
 	itemLookupUUIDDescription  = "The UUID of the item to retrieve. This field will be populated with the UUID of the item if the item it looked up by its title."
 	itemLookupTitleDescription = "The title of the item to retrieve. This field will be populated with the title of the item if the item it looked up by its UUID."
 
-	itemUUIDDescription                  = "The UUID of the item. Item identifiers are unique within a specific vault."
-	vaultUUIDDescription                 = "The UUID of the vault the item is in."
-	categoryDescription                  = "The category of the item."
-	itemTitleDescription                 = "The title of the item."
-	urlDescription                       = "The primary URL for the item."
-	tagsDescription                      = "An array of strings of the tags assigned to the item."
-	usernameDescription                  = "Username for this item."
-	passwordDescription                  = "Password for this item."
-	passwordWriteOnceDescription         = "A write-only password for this item. This value is not stored in the state and is intended for use with ephemeral values. **Note**: Write-only arguments require Terraform 1.11 or later."
-	passwordWriteOnceVersionDescription  = "An integer that must be incremented to trigger an update to the 'password_wo' field."
-	noteValueWriteOnceDescription        = "A write-only secure note value for this item. This value is not stored in the state and is intended for use with ephemeral values. **Note**: Write-only arguments require Terraform 1.11 or later."
-	noteValueWriteOnceVersionDescription = "An integer that must be incremented to trigger an update to the 'note_value_wo' field."
-	noteValueDescription                 = "Secure Note value."
-	publicKeyDescription                 = "SSH Public Key for this item."
-	privateKeyDescription                = "SSH Private Key in PKCS#8 for this item."
-	privateKeyOpenSSHDescription         = "SSH Private key in OpenSSH format."
-	credentialDescription                = "(Only applies to the API credential category) API credential for this item."
-	validFromDescription                 = "(Only applies to the API credential category) The timestamp from which the API credential is valid."
-	filenameDescription                  = "(Only applies to the API credential category) The filename associated with the API credential."
-
-	dbHostnameDescription = "(Only applies to the database category) The address where the database can be found"
+	itemUUIDDescription                   = "The UUID of the item. Item identifiers are unique within a specific vault."
+	itemVersionDescription                = "The version of the item, incremented each time the item is updated."
+	itemCreatedAtDescription              = "The time the item was created, in RFC 3339 format."
+	itemUpdatedAtDescription              = "The time the item was last updated, in RFC 3339 format."
+	itemLastEditedByDescription           = "The UUID of the user or service account that last edited the item. Only available when using 1Password Connect."
+	itemFavoriteDescription               = "Whether the item is marked as a favorite. Only available when using 1Password Connect; always `false` otherwise."
+	conflictDetectionDescription          = "Whether to fail updates when the item was modified outside of Terraform since it was last refreshed, instead of overwriting the changes. The version of the item in state is compared with its current version in 1Password, and the fields that were changed remotely are reported. Run `terraform apply -refresh-only` to accept the remote changes."
+	fieldOwnershipDescription             = "Which fields of the item are owned by Terraform. With `all`, the default, updates replace all fields, sections, tags and websites of the item. With `managed_only`, only the ones declared in the configuration are updated, removed fields that were previously declared are deleted and everything added outside of Terraform, such as notes, sections or one-time passwords added in the 1Password app, is kept and not tracked in state."
+	vaultUUIDDescription                  = "The UUID of the vault the item is in."
+	categoryDescription                   = "The category of the item."
+	itemTitleDescription                  = "The title of the item."
+	urlDescription                        = "The primary URL for the item."
+	tagsDescription                       = "An array of strings of the tags assigned to the item."
+	usernameDescription                   = "Username for this item."
+	passwordDescription                   = "Password for this item."
+	passwordWriteOnceDescription          = "A write-only password for this item. This value is not stored in the state and is intended for use with ephemeral values. **Note**: Write-only arguments require Terraform 1.11 or later."
+	passwordWriteOnceVersionDescription   = "An integer that must be incremented to trigger an update to the 'password_wo' field."
+	noteValueWriteOnceDescription         = "A write-only secure note value for this item. This value is not stored in the state and is intended for use with ephemeral values. **Note**: Write-only arguments require Terraform 1.11 or later."
+	noteValueWriteOnceVersionDescription  = "An integer that must be incremented to trigger an update to the 'note_value_wo' field."
+	noteValueDescription                  = "Secure Note value."
+	publicKeyDescription                  = "SSH Public Key for this item."
+	privateKeyDescription                 = "SSH Private Key in PKCS#8 for this item."
+	privateKeyOpenSSHDescription          = "SSH Private key in OpenSSH format."
+	sshKeyAlgorithmDescription            = "(Only applies to the SSH key category) The algorithm of the SSH key to generate. Changing this generates a new key."
+	sshKeyRSABitsDescription              = "(Only applies to the SSH key category) The size of the RSA key to generate. One of 2048, 3072 or 4096; defaults to 4096. Changing this generates a new key."
+	privateKeyWriteOnceDescription        = "(Only applies to the SSH key category) A write-only private key to import instead of generating one, in PKCS#1, PKCS#8 or OpenSSH PEM format. Only unencrypted RSA and Ed25519 keys are supported. This value is not stored in the state and is intended for use with ephemeral values. **Note**: Write-only arguments require Terraform 1.11 or later."
+	privateKeyWriteOnceVersionDescription = "An integer that must be incremented to trigger an update to the 'private_key_wo' field."
+	sshKeyPublicKeyDescription            = "(Only applies to the SSH key category) The public key in authorized_keys format."
+	sshKeyFingerprintDescription          = "(Only applies to the SSH key category) The SHA256 fingerprint of the public key."
+	sshKeyPrivateKeyOpenSSHDescription    = "(Only applies to the SSH key category) The private key in OpenSSH format. Not set when the key is imported with `private_key_wo`."
+	credentialDescription                 = "(Only applies to the API credential category) API credential for this item."
+	validFromDescription                  = "(Only applies to the API credential category) The timestamp from which the API credential is valid."
+	filenameDescription                   = "(Only applies to the API credential category) The filename associated with the API credential."
+	expiresDescription                    = "(Only applies to the API credential category) The date the API credential expires, in the format `YYYY-MM-DD`."
+	credentialWriteOnceDescription        = "(Only applies to the API credential category) A write-only API credential for this item. This value is not stored in the state and is intended for use with ephemeral values. **Note**: Write-only arguments require Terraform 1.11 or later."
+	credentialWriteOnceVersionDescription = "An integer that must be incremented to trigger an update to the 'credential_wo' field."
+	itemFilenameDescription               = "(Only applies to the API credential and document categories) The filename associated with the API credential, or the name of the document file. Required for documents."
+	documentContentDescription            = "(Only applies to the document category) The content of the document file. Exactly one of `content`, `content_base64` or `source` must be set for documents."
+	documentContentBase64Description      = "(Only applies to the document category) The content of the document file in base64 encoding. (Use this for binary files.)"
+	documentSourceDescription             = "(Only applies to the document category) The path to a local file to upload as the document file."
+	documentContentHashDescription        = "(Only applies to the document category) The SHA256 hash of the document content, used to detect changes to the content, including changes to the file at `source`."
+
+	categoryFieldsDescription = "(Only applies to the `credit_card` category) A map of the built-in fields of the item category, keyed by field ID (`cardholder`, `type`, `ccnum`, `cvv`, `expiry` and `validFrom`). The built-in fields of other categories without dedicated attributes, e.g. `server` or `wireless_router`, aren't supported yet. `MONTH_YEAR` fields use the format `YYYYMM` and `DATE` fields the format `YYYY-MM-DD`. Built-in fields that 1Password stores in sections, such as the fields of an identity, are managed with `section` or `section_map`."
+
+	dbHostnameDescription = "(Only applies to the database and API credential categories) The address where the database or API can be found"
 	dbDatabaseDescription = "(Only applies to the database category) The name of the database."
 	dbPortDescription     = "(Only applies to the database category) The port the database is listening on."
 	dbTypeDescription     = "(Only applies to the database category) The type of database."
 	typeDescription       = "(Only applies to database and API credential categories) The type of database or API Credential."
 
+	websiteListDescription             = "A list of websites of the item. Cannot be used together with `url`."
+	websiteURLDescription              = "The URL of the website."
+	websiteLabelDescription            = "The label of the website."
+	websitePrimaryDescription          = "Whether this is the primary website of the item. Defaults to the first website when no website is marked as primary."
+	websiteAutofillBehaviorDescription = "When 1Password suggests and fills the item on this website. Only stored when using service account or desktop app authentication."
+
 	sectionListDescription  = "A list of custom sections in an item. Cannot be used together with `section_map`. Use either `section` (list) or `section_map` (map), but not both."
 	sectionMapDescription   = "A map of custom sections in an item, keyed by section label. This allows direct lookup of sections and their fields by label. Cannot be used together with `section`. Use either `section` (list) or `section_map` (map), but not both."
 	sectionIDDescription    = "A unique identifier for the section."
@@ -54,6 +85,12 @@ const (
 	fileContentDescription       = "The content of the file."
 	fileContentBase64Description = "The content of the file in base64 encoding. (Use this for binary files.)"
 
+	fileContentWriteOnceDescription       = "A write-only content of the file. Exactly one of `content_wo`, `content_base64_wo` or `source` must be set. **Note**: Write-only arguments require Terraform 1.11 or later."
+	fileContentBase64WriteOnceDescription = "A write-only content of the file in base64 encoding. (Use this for binary files.) **Note**: Write-only arguments require Terraform 1.11 or later."
+	fileSourceDescription                 = "The path to a local file to upload."
+	fileSizeDescription                   = "The size of the file content in bytes."
+	fileContentHashDescription            = "The SHA256 hash of the file content, used to detect changes to the content."
+
 	fieldListDescription  = "A list of custom fields in the section"
 	fieldMapDescription   = "A map of custom fields in the section, keyed by field label."
 	fieldIDDescription    = "A unique identifier for the field."
@@ -61,10 +98,19 @@ const (
 	fieldTypeDescription  = "The type of value stored in the field."
 	fieldValueDescription = "The value of the field."
 
-	passwordRecipeDescription  = "The recipe used to generate a new value for a password."
-	passwordLengthDescription  = "The length of the password to be generated."
-	passwordDigitsDescription  = "Use digits [0-9] when generating the password."
-	passwordSymbolsDescription = "Use symbols [!@.-_*] when generating the password."
+	passwordRecipeDescription            = "The recipe used to generate a new value for a password."
+	passwordTypeDescription              = "The type of password to generate: a random password, a memorable passphrase or a numeric PIN."
+	passwordLengthDescription            = "(Only applies to random passwords and PINs) The length of the password to be generated. PINs must be between 3 and 12 digits long."
+	passwordDigitsDescription            = "(Only applies to random passwords) Use digits [0-9] when generating the password."
+	passwordSymbolsDescription           = "(Only applies to random passwords) Use symbols [!@.-_*] when generating the password."
+	passwordLettersDescription           = "(Only applies to random passwords) Use letters [a-zA-Z] when generating the password."
+	passwordExcludeCharactersDescription = "(Only applies to random passwords) Characters that must not appear in the generated password."
+	passwordMinDigitsDescription         = "(Only applies to random passwords) The minimum number of digits in the generated password. Requires `digits` to be enabled."
+	passwordMinSymbolsDescription        = "(Only applies to random passwords) The minimum number of symbols in the generated password. Requires `symbols` to be enabled."
+	passwordWordCountDescription         = "(Only applies to memorable passwords) The number of words in the generated passphrase."
+	passwordSeparatorDescription         = "(Only applies to memorable passwords) The separator placed between words."
+	passwordCapitalizeDescription        = "(Only applies to memorable passwords) Capitalize one randomly selected word."
+	passwordWordListDescription          = "(Only applies to memorable passwords) The list the words are picked from."
 
 	enumDescription = "%s One of %q"
 
@@ -79,12 +125,59 @@ var (
 		strings.ToLower(string(model.Password)),
 		strings.ToLower(string(model.Database)),
 		strings.ToLower(string(model.SecureNote)),
-	}
-	dataSourceCategories = append(categories,
-		strings.ToLower(string(model.Document)),
 		strings.ToLower(string(model.SSHKey)),
 		strings.ToLower(string(model.APICredential)),
-	)
+		strings.ToLower(string(model.Document)),
+		strings.ToLower(string(model.BankAccount)),
+		strings.ToLower(string(model.CreditCard)),
+		strings.ToLower(string(model.CryptoWallet)),
+		strings.ToLower(string(model.DriverLicense)),
+		strings.ToLower(string(model.EmailAccount)),
+		strings.ToLower(string(model.Identity)),
+		strings.ToLower(string(model.MedicalRecord)),
+		strings.ToLower(string(model.Membership)),
+		strings.ToLower(string(model.OutdoorLicense)),
+		strings.ToLower(string(model.Passport)),
+		strings.ToLower(string(model.Person)),
+		strings.ToLower(string(model.RewardProgram)),
+		strings.ToLower(string(model.Server)),
+		strings.ToLower(string(model.SocialSecurityNumber)),
+		strings.ToLower(string(model.SoftwareLicense)),
+		strings.ToLower(string(model.WirelessRouter)),
+	}
+
+	autofillBehaviors = []string{
+		strings.ToLower(string(model.AutofillBehaviorAnywhereOnWebsite)),
+		strings.ToLower(string(model.AutofillBehaviorExactDomain)),
+		strings.ToLower(string(model.AutofillBehaviorNever)),
+	}
+
+	sshKeyAlgorithms = []string{
+		string(opssh.KeyAlgorithmEd25519),
+		string(opssh.KeyAlgorithmRSA),
+	}
+
+	passwordRecipeTypes = []string{
+		strings.ToLower(string(model.RecipeTypeRandom)),
+		strings.ToLower(string(model.RecipeTypeMemorable)),
+		strings.ToLower(string(model.RecipeTypePin)),
+	}
+
+	passwordSeparators = []string{
+		strings.ToLower(string(model.SeparatorHyphens)),
+		strings.ToLower(string(model.SeparatorSpaces)),
+		strings.ToLower(string(model.SeparatorPeriods)),
+		strings.ToLower(string(model.SeparatorCommas)),
+		strings.ToLower(string(model.SeparatorUnderscores)),
+		strings.ToLower(string(model.SeparatorDigits)),
+		strings.ToLower(string(model.SeparatorDigitsAndSymbols)),
+	}
+
+	passwordWordLists = []string{
+		strings.ToLower(string(model.WordListFullWords)),
+		strings.ToLower(string(model.WordListSyllables)),
+		strings.ToLower(string(model.WordListThreeLetters)),
+	}
 
 	fieldPurposes = []string{
 		string(model.FieldPurposeUsername),
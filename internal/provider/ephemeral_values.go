@@ -31,10 +31,12 @@ func handleWriteOnlyFieldUpdates(
 	// Check if any write-only field needs the current item
 	passwordNeedsItem := shouldFetchCurrentItem(config.PasswordWOVersion, state.PasswordWOVersion)
 	noteValueNeedsItem := shouldFetchCurrentItem(config.NoteValueWOVersion, state.NoteValueWOVersion)
+	privateKeyNeedsItem := shouldFetchCurrentItem(config.PrivateKeyWOVersion, state.PrivateKeyWOVersion)
+	credentialNeedsItem := shouldFetchCurrentItem(config.CredentialWOVersion, state.CredentialWOVersion)
 
 	// Fetch item once if needed
 	var currentItem *model.Item
-	if passwordNeedsItem || noteValueNeedsItem {
+	if passwordNeedsItem || noteValueNeedsItem || privateKeyNeedsItem || credentialNeedsItem {
 		var err error
 		currentItem, err = refreshItem()
 		if err != nil {
@@ -92,6 +94,56 @@ func handleWriteOnlyFieldUpdates(
 		}
 	}
 
+	// Handle private_key_wo
+	if !config.PrivateKeyWOVersion.IsNull() {
+		configVer := config.PrivateKeyWOVersion.ValueInt64()
+		stateVer := int64(0)
+		if !state.PrivateKeyWOVersion.IsNull() {
+			stateVer = state.PrivateKeyWOVersion.ValueInt64()
+		}
+
+		if configVer > stateVer {
+			plan.PrivateKeyOpenSSH = config.PrivateKeyWO
+		} else {
+			fieldFound := false
+			for _, f := range currentItem.Fields {
+				if f.SectionID == "" && f.ID == "private_key" {
+					plan.PrivateKeyOpenSSH = types.StringValue(f.Value)
+					fieldFound = true
+					break
+				}
+			}
+			if !fieldFound {
+				plan.PrivateKeyOpenSSH = types.StringNull()
+			}
+		}
+	}
+
+	// Handle credential_wo
+	if !config.CredentialWOVersion.IsNull() {
+		configVer := config.CredentialWOVersion.ValueInt64()
+		stateVer := int64(0)
+		if !state.CredentialWOVersion.IsNull() {
+			stateVer = state.CredentialWOVersion.ValueInt64()
+		}
+
+		if configVer > stateVer {
+			plan.Credential = config.CredentialWO
+		} else {
+			fieldFound := false
+			for _, f := range currentItem.Fields {
+				if f.SectionID == "" && f.ID == "credential" {
+					plan.Credential = types.StringValue(f.Value)
+					fieldFound = true
+					break
+				}
+			}
+			if !fieldFound {
+				plan.Credential = types.StringNull()
+			}
+		}
+	}
+
 	return nil
 }
 
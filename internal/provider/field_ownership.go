@@ -0,0 +1,198 @@
+package provider
+
+import (
+	"context"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/model"
+)
+
+const (
+	// fieldOwnershipAll replaces all fields, sections, tags and websites of the item on update.
+	fieldOwnershipAll = "all"
+	// fieldOwnershipManagedOnly only updates the fields, sections, tags and websites declared in the configuration.
+	fieldOwnershipManagedOnly = "managed_only"
+)
+
+var fieldOwnerships = []string{fieldOwnershipAll, fieldOwnershipManagedOnly}
+
+func isManagedOnly(data OnePasswordItemResourceModel) bool {
+	return data.FieldOwnership.ValueString() == fieldOwnershipManagedOnly
+}
+
+// toManagedItem returns a copy of the item with only the fields, sections, tags and websites that are tracked by the
+// plan or state, so that the values added outside of Terraform are neither written nor stored in state.
+func toManagedItem(ctx context.Context, item *model.Item, data OnePasswordItemResourceModel) *model.Item {
+	managed := *item
+
+	managed.Fields = make([]model.ItemField, 0, len(item.Fields))
+	for _, f := range item.Fields {
+		if isManagedItemField(ctx, f, item.Category, data) {
+			managed.Fields = append(managed.Fields, f)
+		}
+	}
+
+	managed.Sections = make([]model.ItemSection, 0, len(item.Sections))
+	for _, s := range item.Sections {
+		if isManagedSection(s.Label, data) {
+			managed.Sections = append(managed.Sections, s)
+		}
+	}
+
+	if managedTags, ok := trackedTags(ctx, data); ok {
+		managed.Tags = nil
+		for _, tag := range item.Tags {
+			if slices.Contains(managedTags, tag) {
+				managed.Tags = append(managed.Tags, tag)
+			}
+		}
+	}
+	if managedURLs, ok := trackedURLs(data); ok {
+		managed.URLs = nil
+		for _, u := range item.URLs {
+			if slices.Contains(managedURLs, u.URL) {
+				managed.URLs = append(managed.URLs, u)
+			}
+		}
+	}
+
+	return &managed
+}
+
+// trackedTags returns the tags set in the plan or state. It returns false when the tags are unknown,
+// in which case all tags of the item are considered managed.
+func trackedTags(ctx context.Context, data OnePasswordItemResourceModel) ([]string, bool) {
+	if data.Tags.IsUnknown() {
+		return nil, false
+	}
+	var tags []string
+	if !data.Tags.IsNull() {
+		if diagnostics := data.Tags.ElementsAs(ctx, &tags, true); diagnostics.HasError() {
+			return nil, false
+		}
+	}
+	return tags, true
+}
+
+// trackedURLs returns the URLs set with `url` or `website` in the plan or state. It returns false when one of them is
+// unknown, in which case all websites of the item are considered managed.
+func trackedURLs(data OnePasswordItemResourceModel) ([]string, bool) {
+	if data.URL.IsUnknown() {
+		return nil, false
+	}
+	var urls []string
+	if !data.URL.IsNull() {
+		urls = append(urls, data.URL.ValueString())
+	}
+	for _, w := range data.Website {
+		if w.URL.IsUnknown() {
+			return nil, false
+		}
+		urls = append(urls, w.URL.ValueString())
+	}
+	return urls, true
+}
+
+// isManagedItemField returns whether the attribute or section field the field is stored in is tracked.
+func isManagedItemField(ctx context.Context, f model.ItemField, category model.ItemCategory, data OnePasswordItemResourceModel) bool {
+	if f.SectionID != "" {
+		return isManagedSectionField(f.SectionLabel, f.Label, data)
+	}
+
+	switch f.Purpose {
+	case model.FieldPurposeUsername:
+		return isTracked(data.Username)
+	case model.FieldPurposePassword:
+		return true
+	case model.FieldPurposeNotes:
+		return isTracked(data.NoteValue) || isTracked(data.NoteValueWOVersion)
+	}
+
+	if _, ok := model.FindCategoryField(category, f.ID); ok {
+		return isTrackedCategoryField(ctx, f.ID, data)
+	}
+
+	if f.ID == "private_key" {
+		return true
+	}
+
+	switch f.Label {
+	case "username":
+		return isTracked(data.Username)
+	case "password", "private key":
+		return true
+	case "hostname", "server":
+		return isTracked(data.Hostname)
+	case "database":
+		return isTracked(data.Database)
+	case "port":
+		return isTracked(data.Port)
+	case "type":
+		return isTracked(data.Type)
+	case "credential":
+		return isTracked(data.Credential) || isTracked(data.CredentialWOVersion)
+	case "valid from":
+		return isTracked(data.ValidFrom)
+	case "expires":
+		return isTracked(data.Expires)
+	case "filename":
+		return isTracked(data.Filename)
+	}
+
+	return false
+}
+
+func isManagedSection(label string, data OnePasswordItemResourceModel) bool {
+	if len(data.SectionMap) > 0 {
+		_, ok := data.SectionMap[label]
+		return ok
+	}
+	for _, s := range data.SectionList {
+		if s.Label.ValueString() == label {
+			return true
+		}
+	}
+	return false
+}
+
+func isManagedSectionField(sectionLabel, label string, data OnePasswordItemResourceModel) bool {
+	if len(data.SectionMap) > 0 {
+		section, ok := data.SectionMap[sectionLabel]
+		if !ok {
+			return false
+		}
+		_, ok = section.FieldMap[label]
+		return ok
+	}
+	for _, s := range data.SectionList {
+		if s.Label.ValueString() != sectionLabel {
+			continue
+		}
+		for _, f := range s.FieldList {
+			if f.Label.ValueString() == label {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func isTrackedCategoryField(ctx context.Context, id string, data OnePasswordItemResourceModel) bool {
+	if data.CategoryFields.IsNull() || data.CategoryFields.IsUnknown() {
+		return false
+	}
+	var values map[string]types.String
+	if diagnostics := data.CategoryFields.ElementsAs(ctx, &values, false); diagnostics.HasError() {
+		return false
+	}
+	_, ok := values[id]
+	return ok
+}
+
+// isTracked returns whether the attribute is set in the plan or state. Unknown values are tracked, as they are set on apply.
+func isTracked(value attr.Value) bool {
+	return !value.IsNull()
+}
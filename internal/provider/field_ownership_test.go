@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/model"
+)
+
+func TestToManagedItem(t *testing.T) {
+	item := &model.Item{
+		ID:       "item1",
+		Title:    "Test Item",
+		Category: model.Login,
+		Tags:     []string{"managed", "added-in-app"},
+		URLs: []model.ItemURL{
+			{URL: "https://example.com", Primary: true},
+			{URL: "https://added-in-app.example.com"},
+		},
+		Sections: []model.ItemSection{
+			{ID: "s1", Label: "managed"},
+			{ID: "s2", Label: "added in app"},
+		},
+		Fields: []model.ItemField{
+			{ID: "username", Label: "username", Purpose: model.FieldPurposeUsername, Value: "admin"},
+			{ID: "password", Label: "password", Purpose: model.FieldPurposePassword, Value: "secret"},
+			{ID: "notesPlain", Label: "notesPlain", Purpose: model.FieldPurposeNotes, Value: "notes"},
+			{ID: "f1", Label: "host", SectionID: "s1", SectionLabel: "managed", Value: "db.example.com"},
+			{ID: "f2", Label: "added", SectionID: "s1", SectionLabel: "managed", Value: "value"},
+			{ID: "f3", Label: "one-time password", SectionID: "s2", SectionLabel: "added in app", Value: "otpauth://totp/example"},
+		},
+	}
+
+	tests := map[string]struct {
+		data     OnePasswordItemResourceModel
+		expected *model.Item
+	}{
+		"should keep only tracked fields of section list": {
+			data: OnePasswordItemResourceModel{
+				Username:  types.StringValue("admin"),
+				NoteValue: types.StringNull(),
+				URL:       types.StringNull(),
+				Tags:      types.ListNull(types.StringType),
+				SectionList: []OnePasswordItemResourceSectionListModel{
+					{
+						Label: types.StringValue("managed"),
+						FieldList: []OnePasswordItemResourceFieldModel{
+							{Label: types.StringValue("host")},
+						},
+					},
+				},
+			},
+			expected: &model.Item{
+				ID:       "item1",
+				Title:    "Test Item",
+				Category: model.Login,
+				Sections: []model.ItemSection{
+					{ID: "s1", Label: "managed"},
+				},
+				Fields: []model.ItemField{
+					{ID: "username", Label: "username", Purpose: model.FieldPurposeUsername, Value: "admin"},
+					{ID: "password", Label: "password", Purpose: model.FieldPurposePassword, Value: "secret"},
+					{ID: "f1", Label: "host", SectionID: "s1", SectionLabel: "managed", Value: "db.example.com"},
+				},
+			},
+		},
+		"should keep tracked fields of section map, notes, tags and url": {
+			data: OnePasswordItemResourceModel{
+				Username:  types.StringNull(),
+				NoteValue: types.StringValue("notes"),
+				URL:       types.StringValue("https://example.com"),
+				Tags:      types.ListValueMust(types.StringType, []attr.Value{types.StringValue("managed")}),
+				SectionMap: map[string]OnePasswordItemResourceSectionMapModel{
+					"added in app": {
+						FieldMap: map[string]OnePasswordItemResourceFieldMapModel{
+							"one-time password": {},
+						},
+					},
+				},
+			},
+			expected: &model.Item{
+				ID:       "item1",
+				Title:    "Test Item",
+				Category: model.Login,
+				Tags:     []string{"managed"},
+				URLs:     []model.ItemURL{{URL: "https://example.com", Primary: true}},
+				Sections: []model.ItemSection{
+					{ID: "s2", Label: "added in app"},
+				},
+				Fields: []model.ItemField{
+					{ID: "password", Label: "password", Purpose: model.FieldPurposePassword, Value: "secret"},
+					{ID: "notesPlain", Label: "notesPlain", Purpose: model.FieldPurposeNotes, Value: "notes"},
+					{ID: "f3", Label: "one-time password", SectionID: "s2", SectionLabel: "added in app", Value: "otpauth://totp/example"},
+				},
+			},
+		},
+		"should keep all tags and websites when they are unknown": {
+			data: OnePasswordItemResourceModel{
+				Username:  types.StringNull(),
+				NoteValue: types.StringNull(),
+				URL:       types.StringUnknown(),
+				Tags:      types.ListUnknown(types.StringType),
+			},
+			expected: &model.Item{
+				ID:       "item1",
+				Title:    "Test Item",
+				Category: model.Login,
+				Tags:     []string{"managed", "added-in-app"},
+				URLs: []model.ItemURL{
+					{URL: "https://example.com", Primary: true},
+					{URL: "https://added-in-app.example.com"},
+				},
+				Sections: []model.ItemSection{},
+				Fields: []model.ItemField{
+					{ID: "password", Label: "password", Purpose: model.FieldPurposePassword, Value: "secret"},
+				},
+			},
+		},
+	}
+
+	for description, test := range tests {
+		t.Run(description, func(t *testing.T) {
+			actual := toManagedItem(context.Background(), item, test.data)
+			if !reflect.DeepEqual(actual, test.expected) {
+				t.Errorf("Item mismatch:\ngot      %+v\nexpected %+v", actual, test.expected)
+			}
+		})
+	}
+}
@@ -2,11 +2,13 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"reflect"
 	"sort"
 	"strings"
 
 	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/model"
+	opssh "github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/ssh"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -40,7 +42,7 @@ func toStateTags(ctx context.Context, modelTags []string, stateTags types.List)
 	return stateTags, nil
 }
 
-func toStateSectionsAndFieldsList(modelSections []model.ItemSection, modelFields []model.ItemField, stateSections []OnePasswordItemResourceSectionListModel) []OnePasswordItemResourceSectionListModel {
+func toStateSectionsAndFieldsList(modelSections []model.ItemSection, modelFields []model.ItemField, modelFiles []model.ItemFile, stateSections []OnePasswordItemResourceSectionListModel) []OnePasswordItemResourceSectionListModel {
 	for _, s := range modelSections {
 		section := OnePasswordItemResourceSectionListModel{}
 		posSection := -1
@@ -86,16 +88,7 @@ func toStateSectionsAndFieldsList(modelSections []model.ItemSection, modelFields
 				stateField.Value = setStringValuePreservingEmpty(f.Value, stateField.Value)
 
 				if f.Recipe != nil {
-					charSets := map[string]bool{}
-					for _, s := range f.Recipe.CharacterSets {
-						charSets[strings.ToLower(string(s))] = true
-					}
-
-					stateField.Recipe = []PasswordRecipeModel{{
-						Length:  types.Int64Value(int64(f.Recipe.Length)),
-						Digits:  types.BoolValue(charSets[strings.ToLower(string(model.CharacterSetDigits))]),
-						Symbols: types.BoolValue(charSets[strings.ToLower(string(model.CharacterSetSymbols))]),
-					}}
+					stateField.Recipe = []PasswordRecipeModel{toStateRecipe(f.Recipe)}
 				}
 
 				if newField {
@@ -106,6 +99,7 @@ func toStateSectionsAndFieldsList(modelSections []model.ItemSection, modelFields
 			}
 		}
 		section.FieldList = existingFields
+		section.FileList = toStateSectionFiles(modelFiles, s.ID, section.FileList)
 
 		if newSection {
 			stateSections = append(stateSections, section)
@@ -149,16 +143,8 @@ func toStateSectionsAndFieldsMap(item *model.Item, stateSectionMap map[string]On
 			}
 
 			if modelField.Recipe != nil {
-				charSets := map[string]bool{}
-				for _, s := range modelField.Recipe.CharacterSets {
-					charSets[strings.ToLower(string(s))] = true
-				}
-
-				field.Recipe = &PasswordRecipeModel{
-					Length:  types.Int64Value(int64(modelField.Recipe.Length)),
-					Digits:  types.BoolValue(charSets[strings.ToLower(string(model.CharacterSetDigits))]),
-					Symbols: types.BoolValue(charSets[strings.ToLower(string(model.CharacterSetSymbols))]),
-				}
+				recipe := toStateRecipe(modelField.Recipe)
+				field.Recipe = &recipe
 			} else if sectionExists {
 				// If server didn't return a recipe - preserve from existing plan/state if available
 				if existingField, fieldExists := existingSection.FieldMap[modelField.Label]; fieldExists {
@@ -169,6 +155,10 @@ func toStateSectionsAndFieldsMap(item *model.Item, stateSectionMap map[string]On
 			section.FieldMap[modelField.Label] = field
 		}
 
+		if existingSection, sectionExists := stateSectionMap[modelSection.Label]; sectionExists {
+			section.FileMap = toStateSectionFileMap(item.Files, modelSection.ID, existingSection.FileMap)
+		}
+
 		sectionMap[modelSection.Label] = section
 	}
 
@@ -199,8 +189,139 @@ func toStateTopLevelFields(modelFields []model.ItemField, state *OnePasswordItem
 					state.Port = setStringValuePreservingEmpty(f.Value, state.Port)
 				case "type":
 					state.Type = setStringValue(f.Value)
+				case "credential":
+					state.Credential = setStringValuePreservingEmpty(f.Value, state.Credential)
+				case "valid from":
+					state.ValidFrom = setStringValuePreservingEmpty(f.Value, state.ValidFrom)
+				case "expires":
+					state.Expires = setStringValuePreservingEmpty(f.Value, state.Expires)
+				case "filename":
+					state.Filename = setStringValuePreservingEmpty(f.Value, state.Filename)
 				}
 			}
 		}
 	}
 }
+
+// toStateCategoryFields sets the notes and the built-in fields of categories without dedicated attributes.
+// Empty fields are only kept when they are tracked in state, so that unset fields don't cause a diff.
+func toStateCategoryFields(ctx context.Context, modelItem *model.Item, state *OnePasswordItemResourceModel) diag.Diagnostics {
+	stateValues := map[string]string{}
+	if !state.CategoryFields.IsNull() && !state.CategoryFields.IsUnknown() {
+		diagnostics := state.CategoryFields.ElementsAs(ctx, &stateValues, false)
+		if diagnostics.HasError() {
+			return diagnostics
+		}
+	}
+
+	values := map[string]string{}
+	for _, f := range modelItem.Fields {
+		if f.Purpose == model.FieldPurposeNotes {
+			state.NoteValue = setStringValuePreservingEmpty(f.Value, state.NoteValue)
+			continue
+		}
+		if f.SectionID != "" {
+			continue
+		}
+		if _, ok := model.FindCategoryField(modelItem.Category, f.ID); !ok {
+			continue
+		}
+		if _, tracked := stateValues[f.ID]; f.Value == "" && !tracked {
+			continue
+		}
+		values[f.ID] = f.Value
+	}
+
+	if len(values) == 0 && (state.CategoryFields.IsNull() || state.CategoryFields.IsUnknown()) {
+		state.CategoryFields = types.MapNull(types.StringType)
+		return nil
+	}
+
+	categoryFields, diagnostics := types.MapValueFrom(ctx, types.StringType, values)
+	if diagnostics.HasError() {
+		return diagnostics
+	}
+	state.CategoryFields = categoryFields
+
+	return nil
+}
+
+// toStateSSHKey sets the public key, fingerprint and OpenSSH private key of an SSH key item.
+// The values are null for other categories.
+func toStateSSHKey(modelItem *model.Item, state *OnePasswordItemResourceModel) diag.Diagnostics {
+	state.PublicKey = types.StringNull()
+	state.Fingerprint = types.StringNull()
+	state.PrivateKeyOpenSSH = types.StringNull()
+
+	if modelItem.Category != model.SSHKey {
+		return nil
+	}
+
+	for _, f := range modelItem.Fields {
+		if f.SectionID != "" || f.ID != "private_key" || f.Value == "" {
+			continue
+		}
+
+		publicKey, fingerprint, err := opssh.PublicKeyAndFingerprint([]byte(f.Value))
+		if err != nil {
+			return diag.Diagnostics{diag.NewErrorDiagnostic(
+				"Error parsing SSH key",
+				fmt.Sprintf("Failed to read SSH private key of item '%s', got error: %s", modelItem.ID, err),
+			)}
+		}
+		openSSHPrivateKey, err := opssh.PrivateKeyToOpenSSH([]byte(f.Value), modelItem.ID)
+		if err != nil {
+			return diag.Diagnostics{diag.NewErrorDiagnostic(
+				"Error parsing SSH key",
+				fmt.Sprintf("Unable to convert private key to OpenSSH format, got error: %s", err),
+			)}
+		}
+
+		state.PublicKey = types.StringValue(publicKey)
+		state.Fingerprint = types.StringValue(fingerprint)
+		state.PrivateKeyOpenSSH = types.StringValue(openSSHPrivateKey)
+	}
+
+	return nil
+}
+
+// toStateRecipe converts a generator recipe to its Terraform representation.
+// Options that are not set on the recipe are reported with their schema defaults.
+func toStateRecipe(recipe *model.GeneratorRecipe) PasswordRecipeModel {
+	charSets := map[string]bool{}
+	for _, s := range recipe.CharacterSets {
+		charSets[strings.ToLower(string(s))] = true
+	}
+
+	recipeType := recipe.Type
+	if recipeType == "" {
+		recipeType = model.RecipeTypeRandom
+	}
+	wordCount := recipe.WordCount
+	if wordCount == 0 {
+		wordCount = 4
+	}
+	separator := recipe.Separator
+	if separator == "" {
+		separator = model.SeparatorHyphens
+	}
+	wordList := recipe.WordList
+	if wordList == "" {
+		wordList = model.WordListFullWords
+	}
+
+	return PasswordRecipeModel{
+		Type:              types.StringValue(strings.ToLower(string(recipeType))),
+		Length:            types.Int64Value(int64(recipe.Length)),
+		Digits:            types.BoolValue(charSets[strings.ToLower(string(model.CharacterSetDigits))]),
+		Symbols:           types.BoolValue(charSets[strings.ToLower(string(model.CharacterSetSymbols))]),
+		Letters:           types.BoolValue(!recipe.ExcludeLetters),
+		ExcludeCharacters: types.StringValue(recipe.ExcludeCharacters),
+		MinDigits:         types.Int64Value(int64(recipe.MinDigits)),
+		MinSymbols:        types.Int64Value(int64(recipe.MinSymbols)),
+		WordCount:         types.Int64Value(int64(wordCount)),
+		Separator:         types.StringValue(strings.ToLower(string(separator))),
+		Capitalize:        types.BoolValue(recipe.Capitalize),
+		WordList:          types.StringValue(strings.ToLower(string(wordList))),
+	}
+}
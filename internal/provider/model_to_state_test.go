@@ -469,7 +469,7 @@ func TestToStateSectionsAndFields(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := toStateSectionsAndFieldsList(tt.modelSections, tt.modelFields, tt.stateSections)
+			got := toStateSectionsAndFieldsList(tt.modelSections, tt.modelFields, nil, tt.stateSections)
 			if len(got) != len(tt.want) {
 				t.Errorf("processSectionsAndFields() len = %d, want %d", len(got), len(tt.want))
 				return
@@ -555,6 +555,22 @@ func TestToStateTopLevelFields(t *testing.T) {
 				Type: types.StringValue("mysql"),
 			},
 		},
+		{
+			name: "api credential fields by label",
+			modelFields: []model.ItemField{
+				{Label: "credential", Value: "secret-token", SectionID: ""},
+				{Label: "valid from", Value: "2025-01-01", SectionID: ""},
+				{Label: "expires", Value: "2026-01-01", SectionID: ""},
+				{Label: "filename", Value: "token.txt", SectionID: ""},
+			},
+			state: &OnePasswordItemResourceModel{},
+			want: &OnePasswordItemResourceModel{
+				Credential: types.StringValue("secret-token"),
+				ValidFrom:  types.StringValue("2025-01-01"),
+				Expires:    types.StringValue("2026-01-01"),
+				Filename:   types.StringValue("token.txt"),
+			},
+		},
 		{
 			name: "field in section ignored",
 			modelFields: []model.ItemField{
@@ -723,6 +739,18 @@ func TestToStateTopLevelFields(t *testing.T) {
 			if tt.state.Type.ValueString() != tt.want.Type.ValueString() {
 				t.Errorf("Type = %v, want %v", tt.state.Type.ValueString(), tt.want.Type.ValueString())
 			}
+			if tt.state.Credential.ValueString() != tt.want.Credential.ValueString() {
+				t.Errorf("Credential = %v, want %v", tt.state.Credential.ValueString(), tt.want.Credential.ValueString())
+			}
+			if tt.state.ValidFrom.ValueString() != tt.want.ValidFrom.ValueString() {
+				t.Errorf("ValidFrom = %v, want %v", tt.state.ValidFrom.ValueString(), tt.want.ValidFrom.ValueString())
+			}
+			if tt.state.Expires.ValueString() != tt.want.Expires.ValueString() {
+				t.Errorf("Expires = %v, want %v", tt.state.Expires.ValueString(), tt.want.Expires.ValueString())
+			}
+			if tt.state.Filename.ValueString() != tt.want.Filename.ValueString() {
+				t.Errorf("Filename = %v, want %v", tt.state.Filename.ValueString(), tt.want.Filename.ValueString())
+			}
 		})
 	}
 }
@@ -858,9 +886,18 @@ func TestToStateSectionsAndFieldsMap(t *testing.T) {
 							Type:  types.StringValue("CONCEALED"),
 							Value: types.StringValue("Pass123!@#"),
 							Recipe: &PasswordRecipeModel{
-								Length:  types.Int64Value(20),
-								Digits:  types.BoolValue(true), // Has digits
-								Symbols: types.BoolValue(true), // Has symbols
+								Type:              types.StringValue("random"),
+								Length:            types.Int64Value(20),
+								Digits:            types.BoolValue(true), // Has digits
+								Symbols:           types.BoolValue(true), // Has symbols
+								Letters:           types.BoolValue(true),
+								ExcludeCharacters: types.StringValue(""),
+								MinDigits:         types.Int64Value(0),
+								MinSymbols:        types.Int64Value(0),
+								WordCount:         types.Int64Value(4),
+								Separator:         types.StringValue("hyphens"),
+								Capitalize:        types.BoolValue(false),
+								WordList:          types.StringValue("full_words"),
 							},
 						},
 					},
@@ -896,9 +933,18 @@ func TestToStateSectionsAndFieldsMap(t *testing.T) {
 							Type:  types.StringValue("CONCEALED"),
 							Value: types.StringValue("PasswordOnly"),
 							Recipe: &PasswordRecipeModel{
-								Length:  types.Int64Value(15),
-								Digits:  types.BoolValue(false), // No digits
-								Symbols: types.BoolValue(false), // No symbols
+								Type:              types.StringValue("random"),
+								Length:            types.Int64Value(15),
+								Digits:            types.BoolValue(false), // No digits
+								Symbols:           types.BoolValue(false), // No symbols
+								Letters:           types.BoolValue(true),
+								ExcludeCharacters: types.StringValue(""),
+								MinDigits:         types.Int64Value(0),
+								MinSymbols:        types.Int64Value(0),
+								WordCount:         types.Int64Value(4),
+								Separator:         types.StringValue("hyphens"),
+								Capitalize:        types.BoolValue(false),
+								WordList:          types.StringValue("full_words"),
 							},
 						},
 					},
@@ -1279,3 +1325,112 @@ func TestToStateSectionsAndFieldsMap(t *testing.T) {
 		})
 	}
 }
+
+func TestToStateSSHKey(t *testing.T) {
+	sshKeyItem := generateSSHKeyItem()
+
+	tests := map[string]struct {
+		item          *model.Item
+		wantPublicKey types.String
+		wantSet       bool
+	}{
+		"SSH key item": {
+			item:          sshKeyItem,
+			wantPublicKey: types.StringValue(sshKeyItem.Fields[1].Value),
+			wantSet:       true,
+		},
+		"SSH key item without private key": {
+			item: &model.Item{
+				ID:       "test-item",
+				Category: model.SSHKey,
+			},
+			wantPublicKey: types.StringNull(),
+		},
+		"login item": {
+			item:          generateLoginItem(),
+			wantPublicKey: types.StringNull(),
+		},
+	}
+
+	for description, test := range tests {
+		t.Run(description, func(t *testing.T) {
+			state := &OnePasswordItemResourceModel{}
+			diags := toStateSSHKey(test.item, state)
+			if diags.HasError() {
+				t.Fatalf("Unexpected error: %v", diags)
+			}
+
+			if !state.PublicKey.Equal(test.wantPublicKey) {
+				t.Errorf("PublicKey: got %v, want %v", state.PublicKey, test.wantPublicKey)
+			}
+			if state.Fingerprint.IsNull() == test.wantSet {
+				t.Errorf("Fingerprint: got %v, want set=%v", state.Fingerprint, test.wantSet)
+			}
+			if state.PrivateKeyOpenSSH.IsNull() == test.wantSet {
+				t.Errorf("PrivateKeyOpenSSH: got null=%v, want set=%v", state.PrivateKeyOpenSSH.IsNull(), test.wantSet)
+			}
+		})
+	}
+}
+
+func TestToStateCategoryFields(t *testing.T) {
+	item := &model.Item{
+		Category: model.CreditCard,
+		Fields: []model.ItemField{
+			{ID: "cardholder", Label: "cardholder name", Type: model.FieldTypeString, Value: "Wendy Appleseed"},
+			{ID: "cvv", Label: "verification number", Type: model.FieldTypeConcealed, Value: ""},
+			{ID: "validFrom", Label: "valid from", Type: model.FieldTypeMonthYear, Value: ""},
+			{ID: "custom", Label: "custom", Type: model.FieldTypeString, Value: "not built-in"},
+			{ID: "pin", Label: "PIN", Type: model.FieldTypeConcealed, Value: "1234", SectionID: "details"},
+			{ID: "notesPlain", Label: "notesPlain", Type: model.FieldTypeString, Purpose: model.FieldPurposeNotes, Value: "card notes"},
+		},
+	}
+
+	tests := map[string]struct {
+		stateFields map[string]string
+		want        map[string]string
+	}{
+		"should drop empty fields that are not tracked": {
+			want: map[string]string{"cardholder": "Wendy Appleseed"},
+		},
+		"should keep empty fields that are tracked": {
+			stateFields: map[string]string{"cardholder": "Wendy Appleseed", "cvv": ""},
+			want:        map[string]string{"cardholder": "Wendy Appleseed", "cvv": ""},
+		},
+	}
+
+	for description, test := range tests {
+		t.Run(description, func(t *testing.T) {
+			ctx := context.Background()
+			state := &OnePasswordItemResourceModel{CategoryFields: types.MapNull(types.StringType)}
+			if test.stateFields != nil {
+				state.CategoryFields, _ = types.MapValueFrom(ctx, types.StringType, test.stateFields)
+			}
+
+			diags := toStateCategoryFields(ctx, item, state)
+			if diags.HasError() {
+				t.Fatalf("Unexpected error: %v", diags)
+			}
+
+			var got map[string]string
+			state.CategoryFields.ElementsAs(ctx, &got, false)
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("CategoryFields: got %v, want %v", got, test.want)
+			}
+			if state.NoteValue.ValueString() != "card notes" {
+				t.Errorf("NoteValue: got %v, want %v", state.NoteValue, "card notes")
+			}
+		})
+	}
+
+	t.Run("should stay null without built-in fields", func(t *testing.T) {
+		state := &OnePasswordItemResourceModel{CategoryFields: types.MapNull(types.StringType)}
+		diags := toStateCategoryFields(context.Background(), &model.Item{Category: model.Identity}, state)
+		if diags.HasError() {
+			t.Fatalf("Unexpected error: %v", diags)
+		}
+		if !state.CategoryFields.IsNull() {
+			t.Errorf("CategoryFields: got %v, want null", state.CategoryFields)
+		}
+	})
+}
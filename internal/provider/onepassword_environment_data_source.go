@@ -6,10 +6,12 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword"
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/model"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -107,6 +109,9 @@ func (d *OnePasswordEnvironmentDataSource) Configure(ctx context.Context, req da
 }
 
 func (d *OnePasswordEnvironmentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, warn := warnOnRateLimit(ctx, &resp.Diagnostics)
+	defer warn()
+
 	var data OnePasswordEnvironmentDataSourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -122,26 +127,14 @@ func (d *OnePasswordEnvironmentDataSource) Read(ctx context.Context, req datasou
 		return
 	}
 
-	variablesMap := make(map[string]string)
-	metadataList := make([]envVariableModel, 0, len(variables))
-
-	for _, v := range variables {
-		variablesMap[v.Name] = v.Value
-		metadataList = append(metadataList, envVariableModel{
-			Name:   types.StringValue(v.Name),
-			Value:  types.StringValue(v.Value),
-			Masked: types.BoolValue(v.Masked),
-		})
-	}
-
-	data.ID = types.StringValue(environmentTerraformID(environmentID))
-	data.EnvironmentID = types.StringValue(environmentID)
-
-	variablesMapVal, diags := types.MapValueFrom(ctx, types.StringType, variablesMap)
+	variablesMapVal, metadataList, diags := environmentVariablesToState(ctx, variables)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	data.ID = types.StringValue(environmentTerraformID(environmentID))
+	data.EnvironmentID = types.StringValue(environmentID)
 	data.Variables = variablesMapVal
 	data.Metadata = metadataList
 
@@ -153,3 +146,21 @@ func (d *OnePasswordEnvironmentDataSource) Read(ctx context.Context, req datasou
 func environmentTerraformID(environmentID string) string {
 	return "environments/" + environmentID
 }
+
+// environmentVariablesToState converts environment variables to the `variables` map and `metadata` list shared by the data source and the ephemeral resource.
+func environmentVariablesToState(ctx context.Context, variables []model.EnvironmentVariable) (types.Map, []envVariableModel, diag.Diagnostics) {
+	variablesMap := make(map[string]string)
+	metadataList := make([]envVariableModel, 0, len(variables))
+
+	for _, v := range variables {
+		variablesMap[v.Name] = v.Value
+		metadataList = append(metadataList, envVariableModel{
+			Name:   types.StringValue(v.Name),
+			Value:  types.StringValue(v.Value),
+			Masked: types.BoolValue(v.Masked),
+		})
+	}
+
+	variablesMapVal, diags := types.MapValueFrom(ctx, types.StringType, variablesMap)
+	return variablesMapVal, metadataList, diags
+}
@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ ephemeral.EphemeralResourceWithConfigure = &OnePasswordEnvironmentEphemeral{}
+
+func NewOnePasswordEnvironmentEphemeral() ephemeral.EphemeralResource {
+	return &OnePasswordEnvironmentEphemeral{}
+}
+
+// OnePasswordEnvironmentEphemeral defines the ephemeral resource implementation.
+type OnePasswordEnvironmentEphemeral struct {
+	client onepassword.Client
+}
+
+// OnePasswordEnvironmentEphemeralModel describes the ephemeral resource data model.
+type OnePasswordEnvironmentEphemeralModel struct {
+	ID            types.String       `tfsdk:"id"`
+	EnvironmentID types.String       `tfsdk:"environment_id"`
+	Variables     types.Map          `tfsdk:"variables"`
+	Metadata      []envVariableModel `tfsdk:"metadata"`
+}
+
+func (r *OnePasswordEnvironmentEphemeral) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_environment"
+}
+
+func (r *OnePasswordEnvironmentEphemeral) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this to read environment variables from a [1Password Environment](https://developer.1password.com/docs/environments/) without storing them in Terraform state. " +
+			"Useful for providing environment secrets to write-only arguments, provider configurations or other ephemeral contexts. " +
+			"This ephemeral resource is only supported when using **service account** or **desktop app** authentication; it is not available with 1Password Connect.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The Terraform resource identifier for this environment in the format `environments/<environment_id>`.",
+				Computed:            true,
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the 1Password Environment. You can find this in the 1Password desktop app under Developer > View Environments > Manage environment > Copy environment ID.",
+				Required:            true,
+			},
+			"variables": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "A map of environment variable names to their values.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"metadata": schema.ListNestedBlock{
+				MarkdownDescription: "Metadata for each environment variable (name, value, and masked flag). Use this when you need the full structure; use `variables` for a simple name-to-value map.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The environment variable name.",
+							Computed:            true,
+						},
+						"value": schema.StringAttribute{
+							MarkdownDescription: "The environment variable value.",
+							Computed:            true,
+							Sensitive:           true,
+						},
+						"masked": schema.BoolAttribute{
+							MarkdownDescription: "Whether the value is hidden by default in the 1Password app.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *OnePasswordEnvironmentEphemeral) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(onepassword.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected onepassword.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *OnePasswordEnvironmentEphemeral) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	ctx, warn := warnOnRateLimit(ctx, &resp.Diagnostics)
+	defer warn()
+
+	var data OnePasswordEnvironmentEphemeralModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	environmentID := data.EnvironmentID.ValueString()
+
+	variables, err := r.client.GetEnvironmentVariables(ctx, environmentID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read 1Password Environment variables, got error: %s", err))
+		return
+	}
+
+	variablesMapVal, metadataList, diags := environmentVariablesToState(ctx, variables)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(environmentTerraformID(environmentID))
+	data.EnvironmentID = types.StringValue(environmentID)
+	data.Variables = variablesMapVal
+	data.Metadata = metadataList
+
+	// Save data into ephemeral result data
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/model"
+)
+
+func TestAccEphemeralEnvironment_ConnectNotSupported(t *testing.T) {
+	expectedItem := generateBaseItem()
+	expectedVault := model.Vault{
+		ID:   expectedItem.VaultID,
+		Name: "VaultName",
+	}
+
+	testServer := setupTestServer(&expectedItem, expectedVault, t)
+	defer testServer.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProviderConfig(testServer.URL) + testAccEphemeralEnvironmentConfig("env-id"),
+				ExpectError: regexp.MustCompile("not available with 1Password Connect"),
+			},
+		},
+	})
+}
+
+func testAccEphemeralEnvironmentConfig(environmentID string) string {
+	return fmt.Sprintf(`
+ephemeral "onepassword_environment" "test" {
+  environment_id = "%s"
+}`, environmentID)
+}
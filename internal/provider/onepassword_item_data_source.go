@@ -38,9 +38,15 @@ type OnePasswordItemDataSourceModel struct {
 	ID                types.String                              `tfsdk:"id"`
 	Vault             types.String                              `tfsdk:"vault"`
 	UUID              types.String                              `tfsdk:"uuid"`
+	Version           types.Int64                               `tfsdk:"version"`
+	CreatedAt         types.String                              `tfsdk:"created_at"`
+	UpdatedAt         types.String                              `tfsdk:"updated_at"`
+	LastEditedBy      types.String                              `tfsdk:"last_edited_by"`
+	Favorite          types.Bool                                `tfsdk:"favorite"`
 	Title             types.String                              `tfsdk:"title"`
 	Category          types.String                              `tfsdk:"category"`
 	URL               types.String                              `tfsdk:"url"`
+	Website           []OnePasswordItemWebsiteModel             `tfsdk:"website"`
 	Hostname          types.String                              `tfsdk:"hostname"`
 	Database          types.String                              `tfsdk:"database"`
 	Port              types.String                              `tfsdk:"port"`
@@ -55,6 +61,7 @@ type OnePasswordItemDataSourceModel struct {
 	PublicKey         types.String                              `tfsdk:"public_key"`
 	PrivateKey        types.String                              `tfsdk:"private_key"`
 	PrivateKeyOpenSSH types.String                              `tfsdk:"private_key_openssh"`
+	CategoryFields    types.Map                                 `tfsdk:"category_fields"`
 	SectionList       []OnePasswordItemSectionListModel         `tfsdk:"section"`
 	SectionMap        map[string]OnePasswordItemSectionMapModel `tfsdk:"section_map"`
 	File              []OnePasswordItemFileListModel            `tfsdk:"file"`
@@ -151,19 +158,63 @@ func (d *OnePasswordItemDataSource) Schema(ctx context.Context, req datasource.S
 					}...),
 				},
 			},
+			"version": schema.Int64Attribute{
+				MarkdownDescription: itemVersionDescription,
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: itemCreatedAtDescription,
+				Computed:            true,
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: itemUpdatedAtDescription,
+				Computed:            true,
+			},
+			"last_edited_by": schema.StringAttribute{
+				MarkdownDescription: itemLastEditedByDescription,
+				Computed:            true,
+			},
+			"favorite": schema.BoolAttribute{
+				MarkdownDescription: itemFavoriteDescription,
+				Computed:            true,
+			},
 			"title": schema.StringAttribute{
 				MarkdownDescription: itemLookupTitleDescription,
 				Optional:            true,
 				Computed:            true,
 			},
 			"category": schema.StringAttribute{
-				MarkdownDescription: fmt.Sprintf(enumDescription, categoryDescription, dataSourceCategories),
+				MarkdownDescription: fmt.Sprintf(enumDescription, categoryDescription, categories),
 				Computed:            true,
 			},
 			"url": schema.StringAttribute{
 				MarkdownDescription: urlDescription,
 				Computed:            true,
 			},
+			"website": schema.ListNestedAttribute{
+				MarkdownDescription: websiteListDescription,
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"url": schema.StringAttribute{
+							MarkdownDescription: websiteURLDescription,
+							Computed:            true,
+						},
+						"label": schema.StringAttribute{
+							MarkdownDescription: websiteLabelDescription,
+							Computed:            true,
+						},
+						"primary": schema.BoolAttribute{
+							MarkdownDescription: websitePrimaryDescription,
+							Computed:            true,
+						},
+						"autofill_behavior": schema.StringAttribute{
+							MarkdownDescription: fmt.Sprintf(enumDescription, websiteAutofillBehaviorDescription, autofillBehaviors),
+							Computed:            true,
+						},
+					},
+				},
+			},
 			"hostname": schema.StringAttribute{
 				MarkdownDescription: dbHostnameDescription,
 				Computed:            true,
@@ -227,6 +278,12 @@ func (d *OnePasswordItemDataSource) Schema(ctx context.Context, req datasource.S
 				Computed:            true,
 				Sensitive:           true,
 			},
+			"category_fields": schema.MapAttribute{
+				MarkdownDescription: categoryFieldsDescription,
+				ElementType:         types.StringType,
+				Computed:            true,
+				Sensitive:           true,
+			},
 			"section_map": schema.MapNestedAttribute{
 				MarkdownDescription: sectionMapDescription,
 				Computed:            true,
@@ -362,6 +419,9 @@ func (d *OnePasswordItemDataSource) Configure(ctx context.Context, req datasourc
 }
 
 func (d *OnePasswordItemDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, warn := warnOnRateLimit(ctx, &resp.Diagnostics)
+	defer warn()
+
 	var data OnePasswordItemDataSourceModel
 
 	// Read Terraform configuration data into the model
@@ -383,12 +443,18 @@ func (d *OnePasswordItemDataSource) Read(ctx context.Context, req datasource.Rea
 	data.UUID = types.StringValue(item.ID)
 	data.Vault = types.StringValue(item.VaultID)
 	data.Title = types.StringValue(item.Title)
+	data.Version = types.Int64Value(int64(item.Version))
+	data.CreatedAt = setTimeValue(item.CreatedAt)
+	data.UpdatedAt = setTimeValue(item.UpdatedAt)
+	data.LastEditedBy = setStringValue(item.LastEditedBy)
+	data.Favorite = types.BoolValue(item.Favorite)
 
 	for _, u := range item.URLs {
 		if u.Primary {
 			data.URL = types.StringValue(u.URL)
 		}
 	}
+	data.Website = toDataSourceWebsites(item.URLs)
 
 	tags, diag := types.ListValueFrom(ctx, types.StringType, item.Tags)
 	resp.Diagnostics.Append(diag...)
@@ -399,6 +465,13 @@ func (d *OnePasswordItemDataSource) Read(ctx context.Context, req datasource.Rea
 
 	data.Category = types.StringValue(strings.ToLower(string(item.Category)))
 
+	categoryFields, diag := toDataSourceCategoryFields(ctx, item)
+	resp.Diagnostics.Append(diag...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.CategoryFields = categoryFields
+
 	for _, s := range item.Sections {
 		section := OnePasswordItemSectionListModel{
 			ID:    types.StringValue(s.ID),
@@ -577,3 +650,23 @@ func buildSectionMap(ctx context.Context, item *model.Item, client onepassword.C
 
 	return sectionMap, diagnostics
 }
+
+// toDataSourceCategoryFields returns the built-in fields of categories without dedicated attributes, keyed by field ID.
+// The map is null for other categories.
+func toDataSourceCategoryFields(ctx context.Context, item *model.Item) (types.Map, diag.Diagnostics) {
+	if _, ok := model.CategoryFields(item.Category); !ok {
+		return types.MapNull(types.StringType), nil
+	}
+
+	values := map[string]string{}
+	for _, f := range item.Fields {
+		if f.SectionID != "" {
+			continue
+		}
+		if _, ok := model.FindCategoryField(item.Category, f.ID); ok {
+			values[f.ID] = f.Value
+		}
+	}
+
+	return types.MapValueFrom(ctx, types.StringType, values)
+}
@@ -44,6 +44,34 @@ func TestAccItemDataSourceSections(t *testing.T) {
 	})
 }
 
+func TestAccItemDataSourceMetadata(t *testing.T) {
+	expectedItem := generateItemWithSections()
+	expectedItem.Version = 3
+	expectedVault := model.Vault{
+		ID:          expectedItem.VaultID,
+		Name:        "Name of the vault",
+		Description: "This vault will be retrieved",
+	}
+
+	testServer := setupTestServer(expectedItem, expectedVault, t)
+	defer testServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(testServer.URL) + testAccItemDataSourceConfig(expectedItem.VaultID, expectedItem.ID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.onepassword_item.test", "uuid", expectedItem.ID),
+					resource.TestCheckResourceAttr("data.onepassword_item.test", "version", "3"),
+					resource.TestCheckResourceAttr("data.onepassword_item.test", "favorite", "false"),
+					resource.TestCheckNoResourceAttr("data.onepassword_item.test", "last_edited_by"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccItemDataSourceDatabase(t *testing.T) {
 	expectedItem := generateDatabaseItem()
 	expectedVault := model.Vault{
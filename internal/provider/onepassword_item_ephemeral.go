@@ -31,22 +31,28 @@ type OnePasswordItemEphemeral struct {
 
 // OnePasswordItemEphemeralModel describes the data source data model.
 type OnePasswordItemEphemeralModel struct {
-	ID                types.String `tfsdk:"id"`
-	Vault             types.String `tfsdk:"vault"`
-	UUID              types.String `tfsdk:"uuid"`
-	Title             types.String `tfsdk:"title"`
-	URL               types.String `tfsdk:"url"`
-	Hostname          types.String `tfsdk:"hostname"`
-	Database          types.String `tfsdk:"database"`
-	Port              types.String `tfsdk:"port"`
-	Type              types.String `tfsdk:"type"`
-	Username          types.String `tfsdk:"username"`
-	Password          types.String `tfsdk:"password"`
-	NoteValue         types.String `tfsdk:"note_value"`
-	Credential        types.String `tfsdk:"credential"`
-	PublicKey         types.String `tfsdk:"public_key"`
-	PrivateKey        types.String `tfsdk:"private_key"`
-	PrivateKeyOpenSSH types.String `tfsdk:"private_key_openssh"`
+	ID                types.String                  `tfsdk:"id"`
+	Vault             types.String                  `tfsdk:"vault"`
+	UUID              types.String                  `tfsdk:"uuid"`
+	Version           types.Int64                   `tfsdk:"version"`
+	CreatedAt         types.String                  `tfsdk:"created_at"`
+	UpdatedAt         types.String                  `tfsdk:"updated_at"`
+	LastEditedBy      types.String                  `tfsdk:"last_edited_by"`
+	Favorite          types.Bool                    `tfsdk:"favorite"`
+	Title             types.String                  `tfsdk:"title"`
+	URL               types.String                  `tfsdk:"url"`
+	Website           []OnePasswordItemWebsiteModel `tfsdk:"website"`
+	Hostname          types.String                  `tfsdk:"hostname"`
+	Database          types.String                  `tfsdk:"database"`
+	Port              types.String                  `tfsdk:"port"`
+	Type              types.String                  `tfsdk:"type"`
+	Username          types.String                  `tfsdk:"username"`
+	Password          types.String                  `tfsdk:"password"`
+	NoteValue         types.String                  `tfsdk:"note_value"`
+	Credential        types.String                  `tfsdk:"credential"`
+	PublicKey         types.String                  `tfsdk:"public_key"`
+	PrivateKey        types.String                  `tfsdk:"private_key"`
+	PrivateKeyOpenSSH types.String                  `tfsdk:"private_key_openssh"`
 }
 
 func (r *OnePasswordItemEphemeral) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
@@ -78,6 +84,26 @@ func (r *OnePasswordItemEphemeral) Schema(ctx context.Context, req ephemeral.Sch
 					}...),
 				},
 			},
+			"version": schema.Int64Attribute{
+				MarkdownDescription: itemVersionDescription,
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: itemCreatedAtDescription,
+				Computed:            true,
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: itemUpdatedAtDescription,
+				Computed:            true,
+			},
+			"last_edited_by": schema.StringAttribute{
+				MarkdownDescription: itemLastEditedByDescription,
+				Computed:            true,
+			},
+			"favorite": schema.BoolAttribute{
+				MarkdownDescription: itemFavoriteDescription,
+				Computed:            true,
+			},
 			"title": schema.StringAttribute{
 				MarkdownDescription: itemLookupTitleDescription,
 				Optional:            true,
@@ -87,6 +113,30 @@ func (r *OnePasswordItemEphemeral) Schema(ctx context.Context, req ephemeral.Sch
 				MarkdownDescription: urlDescription,
 				Computed:            true,
 			},
+			"website": schema.ListNestedAttribute{
+				MarkdownDescription: websiteListDescription,
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"url": schema.StringAttribute{
+							MarkdownDescription: websiteURLDescription,
+							Computed:            true,
+						},
+						"label": schema.StringAttribute{
+							MarkdownDescription: websiteLabelDescription,
+							Computed:            true,
+						},
+						"primary": schema.BoolAttribute{
+							MarkdownDescription: websitePrimaryDescription,
+							Computed:            true,
+						},
+						"autofill_behavior": schema.StringAttribute{
+							MarkdownDescription: fmt.Sprintf(enumDescription, websiteAutofillBehaviorDescription, autofillBehaviors),
+							Computed:            true,
+						},
+					},
+				},
+			},
 			"hostname": schema.StringAttribute{
 				MarkdownDescription: dbHostnameDescription,
 				Computed:            true,
@@ -161,6 +211,9 @@ func (r *OnePasswordItemEphemeral) Configure(ctx context.Context, req ephemeral.
 }
 
 func (r *OnePasswordItemEphemeral) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	ctx, warn := warnOnRateLimit(ctx, &resp.Diagnostics)
+	defer warn()
+
 	var data OnePasswordItemEphemeralModel
 
 	// Read Terraform configuration data into the model
@@ -179,12 +232,18 @@ func (r *OnePasswordItemEphemeral) Open(ctx context.Context, req ephemeral.OpenR
 	data.UUID = types.StringValue(item.ID)
 	data.Vault = types.StringValue(item.VaultID)
 	data.Title = types.StringValue(item.Title)
+	data.Version = types.Int64Value(int64(item.Version))
+	data.CreatedAt = setTimeValue(item.CreatedAt)
+	data.UpdatedAt = setTimeValue(item.UpdatedAt)
+	data.LastEditedBy = setStringValue(item.LastEditedBy)
+	data.Favorite = types.BoolValue(item.Favorite)
 
 	for _, u := range item.URLs {
 		if u.Primary {
 			data.URL = types.StringValue(u.URL)
 		}
 	}
+	data.Website = toDataSourceWebsites(item.URLs)
 
 	for _, f := range item.Fields {
 		switch f.Purpose {
@@ -0,0 +1,475 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword"
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/model"
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/util"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &OnePasswordItemFieldResource{}
+var _ resource.ResourceWithImportState = &OnePasswordItemFieldResource{}
+
+// errItemFieldNotFound is returned when the field doesn't exist in the item.
+var errItemFieldNotFound = errors.New("field not found")
+
+func NewOnePasswordItemFieldResource() resource.Resource {
+	return &OnePasswordItemFieldResource{}
+}
+
+// OnePasswordItemFieldResource defines the resource implementation.
+type OnePasswordItemFieldResource struct {
+	client onepassword.Client
+}
+
+// OnePasswordItemFieldResourceModel describes the resource data model.
+type OnePasswordItemFieldResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	Vault     types.String `tfsdk:"vault"`
+	Item      types.String `tfsdk:"item"`
+	Section   types.String `tfsdk:"section"`
+	Label     types.String `tfsdk:"label"`
+	Type      types.String `tfsdk:"type"`
+	Value     types.String `tfsdk:"value"`
+	FieldID   types.String `tfsdk:"field_id"`
+	SectionID types.String `tfsdk:"section_id"`
+}
+
+func (r *OnePasswordItemFieldResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_item_field"
+}
+
+func (r *OnePasswordItemFieldResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "A single field of an existing 1Password item. Use this to manage one field, such as a rotated API token, of an item that is otherwise managed outside of Terraform. The other fields of the item are left untouched. Do not use it for items managed by `onepassword_item`, unless `field_ownership` is `managed_only` and the field is not declared there.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The Terraform resource identifier for this field in the format `vaults/<vault_id>/items/<item_id>/sections/<section_label>/fields/<field_label>`, or `vaults/<vault_id>/items/<item_id>/fields/<field_label>` for fields outside of sections.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"vault": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the vault the item is in.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"item": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the item the field belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"section": schema.StringAttribute{
+				MarkdownDescription: "The label of the section the field is in. The section is created if it doesn't exist, and removed with the field when it has no other fields. Omit it for fields outside of sections.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"label": schema.StringAttribute{
+				MarkdownDescription: fieldLabelDescription,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf(enumDescription, fieldTypeDescription, fieldTypes),
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("STRING"),
+				Validators: []validator.String{
+					stringvalidator.OneOf(fieldTypes...),
+				},
+			},
+			"value": schema.StringAttribute{
+				MarkdownDescription: fieldValueDescription,
+				Required:            true,
+				Sensitive:           true,
+			},
+			"field_id": schema.StringAttribute{
+				MarkdownDescription: fieldIDDescription,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"section_id": schema.StringAttribute{
+				MarkdownDescription: sectionIDDescription,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *OnePasswordItemFieldResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(onepassword.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected onepassword.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *OnePasswordItemFieldResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, warn := warnOnRateLimit(ctx, &resp.Diagnostics)
+	defer warn()
+
+	var plan OnePasswordItemFieldResourceModel
+
+	// Read Terraform plan into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	field, err := r.modifyItem(ctx, plan, func(item *model.Item) (*model.ItemField, error) {
+		if existing := findItemField(item, plan.Section.ValueString(), "", plan.Label.ValueString()); existing != nil {
+			return nil, fmt.Errorf("field '%s' already exists, import it to manage it with Terraform", plan.Label.ValueString())
+		}
+		return setItemField(item, plan)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("1Password Item Field create error", fmt.Sprintf("Could not add field '%s' to item '%s' in vault '%s', got error: %s", plan.Label.ValueString(), plan.Item.ValueString(), plan.Vault.ValueString(), err))
+		return
+	}
+
+	itemFieldToState(field, &plan)
+
+	tflog.Trace(ctx, "created an item field resource")
+
+	// Save plan into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *OnePasswordItemFieldResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, warn := warnOnRateLimit(ctx, &resp.Diagnostics)
+	defer warn()
+
+	var state OnePasswordItemFieldResourceModel
+
+	// Read Terraform prior state into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	item, err := r.client.GetItem(ctx, state.Item.ValueString(), state.Vault.ValueString())
+	if err != nil {
+		// If the item no longer exists, remove the field from state
+		if errors.Is(err, util.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("1Password Item Field read error", fmt.Sprintf("Could not get item '%s' from vault '%s', got error: %s", state.Item.ValueString(), state.Vault.ValueString(), err))
+		return
+	}
+
+	field := findItemField(item, state.Section.ValueString(), state.FieldID.ValueString(), state.Label.ValueString())
+	if field == nil {
+		// The field was removed outside of Terraform, the next plan will add it again
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	itemFieldToState(field, &state)
+
+	// Save updated state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *OnePasswordItemFieldResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, warn := warnOnRateLimit(ctx, &resp.Diagnostics)
+	defer warn()
+
+	var plan OnePasswordItemFieldResourceModel
+
+	// Read Terraform plan into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	field, err := r.modifyItem(ctx, plan, func(item *model.Item) (*model.ItemField, error) {
+		return setItemField(item, plan)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("1Password Item Field update error", fmt.Sprintf("Could not update field '%s' of item '%s' in vault '%s', got error: %s", plan.Label.ValueString(), plan.Item.ValueString(), plan.Vault.ValueString(), err))
+		return
+	}
+
+	itemFieldToState(field, &plan)
+
+	// Save updated plan into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *OnePasswordItemFieldResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, warn := warnOnRateLimit(ctx, &resp.Diagnostics)
+	defer warn()
+
+	var state OnePasswordItemFieldResourceModel
+
+	// Read Terraform prior state into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.modifyItem(ctx, state, func(item *model.Item) (*model.ItemField, error) {
+		if !removeItemField(item, state.Section.ValueString(), state.FieldID.ValueString(), state.Label.ValueString()) {
+			return nil, errItemFieldNotFound
+		}
+		return nil, nil
+	})
+	if err != nil && !errors.Is(err, errItemFieldNotFound) && !errors.Is(err, util.ErrNotFound) {
+		resp.Diagnostics.AddError("1Password Item Field delete error", fmt.Sprintf("Could not remove field '%s' from item '%s' in vault '%s', got error: %s", state.Label.ValueString(), state.Item.ValueString(), state.Vault.ValueString(), err))
+		return
+	}
+}
+
+func (r *OnePasswordItemFieldResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	vaultUUID, itemUUID, sectionLabel, fieldLabel, ok := itemFieldFromTerraformID(req.ID)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"1Password Item Field import error",
+			fmt.Sprintf("Invalid import ID '%s', expected format `vaults/<vault_id>/items/<item_id>/sections/<section_label>/fields/<field_label>` or `vaults/<vault_id>/items/<item_id>/fields/<field_label>`", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vault"), vaultUUID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("item"), itemUUID)...)
+	if sectionLabel != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("section"), sectionLabel)...)
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("label"), fieldLabel)...)
+}
+
+// modifyItem reads the item, applies the change and writes it back. The update only succeeds when the item
+// wasn't changed since it was read, and is retried with the latest version of the item on conflicts.
+func (r *OnePasswordItemFieldResource) modifyItem(ctx context.Context, data OnePasswordItemFieldResourceModel, modify func(item *model.Item) (*model.ItemField, error)) (*model.ItemField, error) {
+	var field *model.ItemField
+	err := util.RetryOnConflict(ctx, r.client.RetryPolicy(), func() error {
+		item, err := r.client.GetItem(ctx, data.Item.ValueString(), data.Vault.ValueString())
+		if err != nil {
+			return err
+		}
+
+		// The whole item is written back, which would corrupt the fields the provider can't convert
+		if unsupported := item.UnsupportedFields(); len(unsupported) > 0 {
+			return fmt.Errorf("the item can't be modified because the type of its fields %q isn't supported by the provider", unsupported)
+		}
+
+		field, err = modify(item)
+		if err != nil {
+			return err
+		}
+
+		updatedItem, err := r.client.UpdateItem(ctx, item, data.Vault.ValueString())
+		if err != nil {
+			return err
+		}
+
+		// Return the field as stored by 1Password
+		if field != nil {
+			if updatedField := findItemField(updatedItem, field.SectionLabel, field.ID, field.Label); updatedField != nil {
+				field = updatedField
+			}
+		}
+		return nil
+	})
+	return field, err
+}
+
+// setItemField sets the value and type of the field, adding the field and its section when they don't exist.
+func setItemField(item *model.Item, data OnePasswordItemFieldResourceModel) (*model.ItemField, error) {
+	sectionLabel := data.Section.ValueString()
+	fieldType := model.ItemFieldType(data.Type.ValueString())
+
+	if field := findItemField(item, sectionLabel, data.FieldID.ValueString(), data.Label.ValueString()); field != nil {
+		field.Type = fieldType
+		field.Value = data.Value.ValueString()
+		return field, nil
+	}
+
+	sectionID := ""
+	if sectionLabel != "" {
+		section := findItemSection(item, sectionLabel)
+		if section == nil {
+			id, err := uuid.GenerateUUID()
+			if err != nil {
+				return nil, fmt.Errorf("unable to generate a section ID: %w", err)
+			}
+			item.Sections = append(item.Sections, model.ItemSection{ID: id, Label: sectionLabel})
+			section = &item.Sections[len(item.Sections)-1]
+		}
+		sectionID = section.ID
+	}
+
+	fieldID, err := uuid.GenerateUUID()
+	if err != nil {
+		return nil, fmt.Errorf("unable to generate a field ID: %w", err)
+	}
+	if fieldType == model.FieldTypeOTP {
+		fieldID = OTPFieldIDPrefix + fieldID
+	}
+
+	item.Fields = append(item.Fields, model.ItemField{
+		ID:           fieldID,
+		Label:        data.Label.ValueString(),
+		Type:         fieldType,
+		Value:        data.Value.ValueString(),
+		SectionID:    sectionID,
+		SectionLabel: sectionLabel,
+	})
+	return &item.Fields[len(item.Fields)-1], nil
+}
+
+// removeItemField removes the field from the item, together with its section when the section has no other fields or files.
+// It returns false when the field doesn't exist.
+func removeItemField(item *model.Item, sectionLabel, fieldID, label string) bool {
+	field := findItemField(item, sectionLabel, fieldID, label)
+	if field == nil {
+		return false
+	}
+	removed := *field
+
+	fields := make([]model.ItemField, 0, len(item.Fields))
+	for _, f := range item.Fields {
+		if f.ID == removed.ID && f.SectionID == removed.SectionID && f.Label == removed.Label {
+			continue
+		}
+		fields = append(fields, f)
+	}
+	item.Fields = fields
+
+	if removed.SectionID == "" {
+		return true
+	}
+	for _, f := range item.Fields {
+		if f.SectionID == removed.SectionID {
+			return true
+		}
+	}
+	for _, f := range item.Files {
+		if f.SectionID == removed.SectionID {
+			return true
+		}
+	}
+	sections := make([]model.ItemSection, 0, len(item.Sections))
+	for _, s := range item.Sections {
+		if s.ID != removed.SectionID {
+			sections = append(sections, s)
+		}
+	}
+	item.Sections = sections
+
+	return true
+}
+
+// findItemField returns the field with the given ID, or with the given label when no field has the ID.
+// Only fields in the section with the given label, or outside of sections when the label is empty, are considered.
+func findItemField(item *model.Item, sectionLabel, fieldID, label string) *model.ItemField {
+	var byLabel *model.ItemField
+	for i := range item.Fields {
+		f := &item.Fields[i]
+		if f.SectionLabel != sectionLabel || (sectionLabel == "" && f.SectionID != "") {
+			continue
+		}
+		if fieldID != "" && f.ID == fieldID {
+			return f
+		}
+		if byLabel == nil && f.Label == label {
+			byLabel = f
+		}
+	}
+	return byLabel
+}
+
+func findItemSection(item *model.Item, label string) *model.ItemSection {
+	for i := range item.Sections {
+		if item.Sections[i].Label == label {
+			return &item.Sections[i]
+		}
+	}
+	return nil
+}
+
+func itemFieldToState(field *model.ItemField, state *OnePasswordItemFieldResourceModel) {
+	state.ID = types.StringValue(itemFieldTerraformID(state.Vault.ValueString(), state.Item.ValueString(), field.SectionLabel, field.Label))
+	state.FieldID = types.StringValue(field.ID)
+	state.SectionID = setStringValue(field.SectionID)
+	state.Type = types.StringValue(string(field.Type))
+	state.Value = types.StringValue(field.Value)
+}
+
+func itemFieldTerraformID(vaultUUID, itemUUID, sectionLabel, fieldLabel string) string {
+	if sectionLabel == "" {
+		return fmt.Sprintf("vaults/%s/items/%s/fields/%s", vaultUUID, itemUUID, fieldLabel)
+	}
+	return fmt.Sprintf("vaults/%s/items/%s/sections/%s/fields/%s", vaultUUID, itemUUID, sectionLabel, fieldLabel)
+}
+
+// itemFieldFromTerraformID parses the identifier of a field. Section and field labels may contain slashes.
+func itemFieldFromTerraformID(tfID string) (vaultUUID, itemUUID, sectionLabel, fieldLabel string, ok bool) {
+	elements := strings.SplitN(tfID, "/", 5)
+	if len(elements) != 5 || elements[0] != "vaults" || elements[2] != "items" || elements[1] == "" || elements[3] == "" {
+		return "", "", "", "", false
+	}
+	vaultUUID, itemUUID = elements[1], elements[3]
+	rest := elements[4]
+
+	if label, found := strings.CutPrefix(rest, "fields/"); found {
+		return vaultUUID, itemUUID, "", label, label != ""
+	}
+
+	rest, found := strings.CutPrefix(rest, "sections/")
+	if !found {
+		return "", "", "", "", false
+	}
+	i := strings.LastIndex(rest, "/fields/")
+	if i <= 0 || i+len("/fields/") == len(rest) {
+		return "", "", "", "", false
+	}
+	return vaultUUID, itemUUID, rest[:i], rest[i+len("/fields/"):], true
+}
@@ -0,0 +1,362 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	sdk "github.com/1password/onepassword-sdk-go"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword"
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/model"
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/util"
+)
+
+// conflictClient fails the first updates with a conflict, as if the item was changed in between.
+type conflictClient struct {
+	onepassword.Client
+	item      model.Item
+	conflicts int
+	updates   int
+	retry     util.RetryPolicy
+}
+
+func (c *conflictClient) RetryPolicy() util.RetryPolicy {
+	return c.retry
+}
+
+func (c *conflictClient) GetItem(_ context.Context, _, _ string) (*model.Item, error) {
+	item := c.item
+	item.Fields = append([]model.ItemField{}, c.item.Fields...)
+	item.Sections = append([]model.ItemSection{}, c.item.Sections...)
+	return &item, nil
+}
+
+func (c *conflictClient) UpdateItem(_ context.Context, item *model.Item, _ string) (*model.Item, error) {
+	c.updates++
+	if c.updates <= c.conflicts {
+		return nil, util.NewError(util.ErrConflict, errors.New("version conflict, item version is 2, expected 1"))
+	}
+	c.item = *item
+	return item, nil
+}
+
+func TestSetItemField(t *testing.T) {
+	tests := map[string]struct {
+		data             OnePasswordItemFieldResourceModel
+		expectedSections []model.ItemSection
+		expectedField    model.ItemField
+	}{
+		"should update existing field": {
+			data: OnePasswordItemFieldResourceModel{
+				Section: types.StringValue("API"),
+				Label:   types.StringValue("token"),
+				Type:    types.StringValue("CONCEALED"),
+				Value:   types.StringValue("rotated"),
+			},
+			expectedSections: []model.ItemSection{{ID: "s1", Label: "API"}},
+			expectedField:    model.ItemField{ID: "f1", Label: "token", Type: model.FieldTypeConcealed, Value: "rotated", SectionID: "s1", SectionLabel: "API"},
+		},
+		"should add field to existing section": {
+			data: OnePasswordItemFieldResourceModel{
+				Section: types.StringValue("API"),
+				Label:   types.StringValue("client id"),
+				Type:    types.StringValue("STRING"),
+				Value:   types.StringValue("id"),
+			},
+			expectedSections: []model.ItemSection{{ID: "s1", Label: "API"}},
+			expectedField:    model.ItemField{Label: "client id", Type: model.FieldTypeString, Value: "id", SectionID: "s1", SectionLabel: "API"},
+		},
+		"should add field outside of sections": {
+			data: OnePasswordItemFieldResourceModel{
+				Section: types.StringNull(),
+				Label:   types.StringValue("token"),
+				Type:    types.StringValue("CONCEALED"),
+				Value:   types.StringValue("top-level"),
+			},
+			expectedSections: []model.ItemSection{{ID: "s1", Label: "API"}},
+			expectedField:    model.ItemField{Label: "token", Type: model.FieldTypeConcealed, Value: "top-level"},
+		},
+	}
+
+	for description, test := range tests {
+		t.Run(description, func(t *testing.T) {
+			item := &model.Item{
+				Sections: []model.ItemSection{{ID: "s1", Label: "API"}},
+				Fields: []model.ItemField{
+					{ID: "f1", Label: "token", Type: model.FieldTypeConcealed, Value: "old", SectionID: "s1", SectionLabel: "API"},
+				},
+			}
+
+			field, err := setItemField(item, test.data)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if test.expectedField.ID == "" {
+				if field.ID == "" {
+					t.Errorf("Expected a generated field ID")
+				}
+				test.expectedField.ID = field.ID
+			}
+			if !reflect.DeepEqual(*field, test.expectedField) {
+				t.Errorf("Expected field %+v, got %+v", test.expectedField, *field)
+			}
+			if !reflect.DeepEqual(item.Sections, test.expectedSections) {
+				t.Errorf("Expected sections %+v, got %+v", test.expectedSections, item.Sections)
+			}
+		})
+	}
+}
+
+func TestSetItemFieldNewSection(t *testing.T) {
+	item := &model.Item{}
+	field, err := setItemField(item, OnePasswordItemFieldResourceModel{
+		Section: types.StringValue("Rotation"),
+		Label:   types.StringValue("totp"),
+		Type:    types.StringValue("OTP"),
+		Value:   types.StringValue("otpauth://totp/example"),
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(item.Sections) != 1 || item.Sections[0].Label != "Rotation" || item.Sections[0].ID == "" {
+		t.Fatalf("Expected a new section labelled 'Rotation', got %+v", item.Sections)
+	}
+	if field.SectionID != item.Sections[0].ID {
+		t.Errorf("Expected field in section %s, got %s", item.Sections[0].ID, field.SectionID)
+	}
+	if !strings.HasPrefix(field.ID, OTPFieldIDPrefix) {
+		t.Errorf("Expected OTP field ID with prefix %s, got %s", OTPFieldIDPrefix, field.ID)
+	}
+}
+
+func TestRemoveItemField(t *testing.T) {
+	tests := map[string]struct {
+		sectionLabel     string
+		label            string
+		expectedFound    bool
+		expectedSections []model.ItemSection
+		expectedFields   []string
+	}{
+		"should keep section with other fields": {
+			sectionLabel:     "API",
+			label:            "token",
+			expectedFound:    true,
+			expectedSections: []model.ItemSection{{ID: "s1", Label: "API"}, {ID: "s2", Label: "Rotation"}},
+			expectedFields:   []string{"client id", "last rotated", "username"},
+		},
+		"should remove empty section": {
+			sectionLabel:     "Rotation",
+			label:            "last rotated",
+			expectedFound:    true,
+			expectedSections: []model.ItemSection{{ID: "s1", Label: "API"}},
+			expectedFields:   []string{"token", "client id", "username"},
+		},
+		"should remove field outside of sections": {
+			label:            "username",
+			expectedFound:    true,
+			expectedSections: []model.ItemSection{{ID: "s1", Label: "API"}, {ID: "s2", Label: "Rotation"}},
+			expectedFields:   []string{"token", "client id", "last rotated"},
+		},
+		"should report missing field": {
+			sectionLabel:     "Rotation",
+			label:            "token",
+			expectedSections: []model.ItemSection{{ID: "s1", Label: "API"}, {ID: "s2", Label: "Rotation"}},
+			expectedFields:   []string{"token", "client id", "last rotated", "username"},
+		},
+	}
+
+	for description, test := range tests {
+		t.Run(description, func(t *testing.T) {
+			item := &model.Item{
+				Sections: []model.ItemSection{{ID: "s1", Label: "API"}, {ID: "s2", Label: "Rotation"}},
+				Fields: []model.ItemField{
+					{ID: "f1", Label: "token", SectionID: "s1", SectionLabel: "API"},
+					{ID: "f2", Label: "client id", SectionID: "s1", SectionLabel: "API"},
+					{ID: "f3", Label: "last rotated", SectionID: "s2", SectionLabel: "Rotation"},
+					{ID: "username", Label: "username"},
+				},
+			}
+
+			found := removeItemField(item, test.sectionLabel, "", test.label)
+			if found != test.expectedFound {
+				t.Errorf("Expected found %v, got %v", test.expectedFound, found)
+			}
+
+			var labels []string
+			for _, f := range item.Fields {
+				labels = append(labels, f.Label)
+			}
+			if !reflect.DeepEqual(labels, test.expectedFields) {
+				t.Errorf("Expected fields %v, got %v", test.expectedFields, labels)
+			}
+			if !reflect.DeepEqual(item.Sections, test.expectedSections) {
+				t.Errorf("Expected sections %+v, got %+v", test.expectedSections, item.Sections)
+			}
+		})
+	}
+}
+
+func TestItemFieldFromTerraformID(t *testing.T) {
+	tests := map[string]struct {
+		id              string
+		expectedVault   string
+		expectedItem    string
+		expectedSection string
+		expectedLabel   string
+		expectedOK      bool
+	}{
+		"should parse field in section": {
+			id:              "vaults/vault1/items/item1/sections/API/fields/token",
+			expectedVault:   "vault1",
+			expectedItem:    "item1",
+			expectedSection: "API",
+			expectedLabel:   "token",
+			expectedOK:      true,
+		},
+		"should parse labels with slashes": {
+			id:              "vaults/vault1/items/item1/sections/CI/CD/fields/token/secret",
+			expectedVault:   "vault1",
+			expectedItem:    "item1",
+			expectedSection: "CI/CD",
+			expectedLabel:   "token/secret",
+			expectedOK:      true,
+		},
+		"should parse field outside of sections": {
+			id:            "vaults/vault1/items/item1/fields/username",
+			expectedVault: "vault1",
+			expectedItem:  "item1",
+			expectedLabel: "username",
+			expectedOK:    true,
+		},
+		"should reject item ID": {
+			id: "vaults/vault1/items/item1",
+		},
+		"should reject missing field label": {
+			id: "vaults/vault1/items/item1/sections/API/fields/",
+		},
+		"should reject unknown format": {
+			id: "vault1/item1/API/token",
+		},
+	}
+
+	for description, test := range tests {
+		t.Run(description, func(t *testing.T) {
+			vaultUUID, itemUUID, sectionLabel, label, ok := itemFieldFromTerraformID(test.id)
+			if ok != test.expectedOK {
+				t.Fatalf("Expected ok %v, got %v", test.expectedOK, ok)
+			}
+			if vaultUUID != test.expectedVault || itemUUID != test.expectedItem || sectionLabel != test.expectedSection || label != test.expectedLabel {
+				t.Errorf("Expected %q %q %q %q, got %q %q %q %q", test.expectedVault, test.expectedItem, test.expectedSection, test.expectedLabel, vaultUUID, itemUUID, sectionLabel, label)
+			}
+		})
+	}
+}
+
+func TestItemFieldModifyItemRetriesOnConflict(t *testing.T) {
+	client := &conflictClient{
+		item: model.Item{
+			ID:       "item1",
+			VaultID:  "vault1",
+			Sections: []model.ItemSection{{ID: "s1", Label: "API"}},
+			Fields: []model.ItemField{
+				{ID: "f1", Label: "token", Type: model.FieldTypeConcealed, Value: "old", SectionID: "s1", SectionLabel: "API"},
+			},
+		},
+		conflicts: 1,
+	}
+	r := &OnePasswordItemFieldResource{client: client}
+	data := OnePasswordItemFieldResourceModel{
+		Vault:   types.StringValue("vault1"),
+		Item:    types.StringValue("item1"),
+		Section: types.StringValue("API"),
+		Label:   types.StringValue("token"),
+		Type:    types.StringValue("CONCEALED"),
+		Value:   types.StringValue("rotated"),
+	}
+
+	field, err := r.modifyItem(context.Background(), data, func(item *model.Item) (*model.ItemField, error) {
+		return setItemField(item, data)
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if client.updates != 2 {
+		t.Errorf("Expected 2 updates, got %d", client.updates)
+	}
+	if field.Value != "rotated" || client.item.Fields[0].Value != "rotated" {
+		t.Errorf("Expected rotated value, got %q in field and %q in item", field.Value, client.item.Fields[0].Value)
+	}
+}
+
+func TestItemFieldModifyItemUsesRetryPolicyOfClient(t *testing.T) {
+	client := &conflictClient{
+		item: model.Item{
+			ID:      "item1",
+			VaultID: "vault1",
+			Fields: []model.ItemField{
+				{ID: "f1", Label: "token", Type: model.FieldTypeConcealed, Value: "old"},
+			},
+		},
+		conflicts: 5,
+		retry:     util.RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond},
+	}
+	r := &OnePasswordItemFieldResource{client: client}
+	data := OnePasswordItemFieldResourceModel{
+		Vault: types.StringValue("vault1"),
+		Item:  types.StringValue("item1"),
+		Label: types.StringValue("token"),
+		Type:  types.StringValue("CONCEALED"),
+		Value: types.StringValue("rotated"),
+	}
+
+	_, err := r.modifyItem(context.Background(), data, func(item *model.Item) (*model.ItemField, error) {
+		return setItemField(item, data)
+	})
+	if !errors.Is(err, util.ErrConflict) {
+		t.Errorf("Expected conflict error, got %v", err)
+	}
+	if client.updates != 2 {
+		t.Errorf("Expected 2 updates, got %d", client.updates)
+	}
+}
+
+func TestItemFieldModifyItemRejectsUnsupportedFields(t *testing.T) {
+	item := model.Item{}
+	err := item.FromSDKItemToModel(&sdk.Item{
+		ID:       "item1",
+		VaultID:  "vault1",
+		Category: sdk.ItemCategoryIdentity,
+		Fields: []sdk.ItemField{
+			{ID: "address", Title: "address", FieldType: sdk.ItemFieldTypeAddress},
+			{ID: "token", Title: "token", FieldType: sdk.ItemFieldTypeConcealed, Value: "old"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	client := &conflictClient{item: item}
+	r := &OnePasswordItemFieldResource{client: client}
+	data := OnePasswordItemFieldResourceModel{
+		Vault: types.StringValue("vault1"),
+		Item:  types.StringValue("item1"),
+		Label: types.StringValue("token"),
+		Type:  types.StringValue("CONCEALED"),
+		Value: types.StringValue("rotated"),
+	}
+
+	_, err = r.modifyItem(context.Background(), data, func(item *model.Item) (*model.ItemField, error) {
+		return setItemField(item, data)
+	})
+	if err == nil || !strings.Contains(err.Error(), "address") {
+		t.Errorf("Expected error naming the address field, got %v", err)
+	}
+	if client.updates != 0 {
+		t.Errorf("Expected no updates, got %d", client.updates)
+	}
+}
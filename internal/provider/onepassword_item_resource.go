@@ -2,9 +2,12 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"regexp"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
@@ -15,21 +18,28 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword"
 	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/model"
+	opssh "github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/ssh"
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/util"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &OnePasswordItemResource{}
 var _ resource.ResourceWithImportState = &OnePasswordItemResource{}
 var _ resource.ResourceWithValidateConfig = &OnePasswordItemResource{}
+var _ resource.ResourceWithUpgradeState = &OnePasswordItemResource{}
 
 func NewOnePasswordItemResource() resource.Resource {
 	return &OnePasswordItemResource{}
@@ -42,33 +52,68 @@ type OnePasswordItemResource struct {
 
 // OnePasswordItemResourceModel describes the resource data model.
 type OnePasswordItemResourceModel struct {
-	ID                 types.String                                      `tfsdk:"id"`
-	UUID               types.String                                      `tfsdk:"uuid"`
-	Vault              types.String                                      `tfsdk:"vault"`
-	Category           types.String                                      `tfsdk:"category"`
-	Title              types.String                                      `tfsdk:"title"`
-	URL                types.String                                      `tfsdk:"url"`
-	Hostname           types.String                                      `tfsdk:"hostname"`
-	Database           types.String                                      `tfsdk:"database"`
-	Port               types.String                                      `tfsdk:"port"`
-	Type               types.String                                      `tfsdk:"type"`
-	Tags               types.List                                        `tfsdk:"tags"`
-	Username           types.String                                      `tfsdk:"username"`
-	Password           types.String                                      `tfsdk:"password"`
-	PasswordWO         types.String                                      `tfsdk:"password_wo"`
-	PasswordWOVersion  types.Int64                                       `tfsdk:"password_wo_version"`
-	NoteValue          types.String                                      `tfsdk:"note_value"`
-	NoteValueWO        types.String                                      `tfsdk:"note_value_wo"`
-	NoteValueWOVersion types.Int64                                       `tfsdk:"note_value_wo_version"`
-	SectionList        []OnePasswordItemResourceSectionListModel         `tfsdk:"section"`
-	SectionMap         map[string]OnePasswordItemResourceSectionMapModel `tfsdk:"section_map"`
-	Recipe             []PasswordRecipeModel                             `tfsdk:"password_recipe"`
+	ID                  types.String                                      `tfsdk:"id"`
+	UUID                types.String                                      `tfsdk:"uuid"`
+	Version             types.Int64                                       `tfsdk:"version"`
+	CreatedAt           types.String                                      `tfsdk:"created_at"`
+	UpdatedAt           types.String                                      `tfsdk:"updated_at"`
+	LastEditedBy        types.String                                      `tfsdk:"last_edited_by"`
+	Favorite            types.Bool                                        `tfsdk:"favorite"`
+	ConflictDetection   types.Bool                                        `tfsdk:"conflict_detection"`
+	FieldOwnership      types.String                                      `tfsdk:"field_ownership"`
+	Vault               types.String                                      `tfsdk:"vault"`
+	Category            types.String                                      `tfsdk:"category"`
+	Title               types.String                                      `tfsdk:"title"`
+	URL                 types.String                                      `tfsdk:"url"`
+	Hostname            types.String                                      `tfsdk:"hostname"`
+	Database            types.String                                      `tfsdk:"database"`
+	Port                types.String                                      `tfsdk:"port"`
+	Type                types.String                                      `tfsdk:"type"`
+	Tags                types.List                                        `tfsdk:"tags"`
+	Username            types.String                                      `tfsdk:"username"`
+	Password            types.String                                      `tfsdk:"password"`
+	PasswordWO          types.String                                      `tfsdk:"password_wo"`
+	PasswordWOVersion   types.Int64                                       `tfsdk:"password_wo_version"`
+	NoteValue           types.String                                      `tfsdk:"note_value"`
+	NoteValueWO         types.String                                      `tfsdk:"note_value_wo"`
+	NoteValueWOVersion  types.Int64                                       `tfsdk:"note_value_wo_version"`
+	SSHKeyAlgorithm     types.String                                      `tfsdk:"ssh_key_algorithm"`
+	SSHKeyRSABits       types.Int64                                       `tfsdk:"ssh_key_rsa_bits"`
+	PrivateKeyWO        types.String                                      `tfsdk:"private_key_wo"`
+	PrivateKeyWOVersion types.Int64                                       `tfsdk:"private_key_wo_version"`
+	PublicKey           types.String                                      `tfsdk:"public_key"`
+	Fingerprint         types.String                                      `tfsdk:"fingerprint"`
+	PrivateKeyOpenSSH   types.String                                      `tfsdk:"private_key_openssh"`
+	Credential          types.String                                      `tfsdk:"credential"`
+	CredentialWO        types.String                                      `tfsdk:"credential_wo"`
+	CredentialWOVersion types.Int64                                       `tfsdk:"credential_wo_version"`
+	ValidFrom           types.String                                      `tfsdk:"valid_from"`
+	Expires             types.String                                      `tfsdk:"expires"`
+	Filename            types.String                                      `tfsdk:"filename"`
+	Content             types.String                                      `tfsdk:"content"`
+	ContentBase64       types.String                                      `tfsdk:"content_base64"`
+	Source              types.String                                      `tfsdk:"source"`
+	ContentHash         types.String                                      `tfsdk:"content_hash"`
+	CategoryFields      types.Map                                         `tfsdk:"category_fields"`
+	Website             []OnePasswordItemWebsiteModel                     `tfsdk:"website"`
+	SectionList         []OnePasswordItemResourceSectionListModel         `tfsdk:"section"`
+	SectionMap          map[string]OnePasswordItemResourceSectionMapModel `tfsdk:"section_map"`
+	Recipe              []PasswordRecipeModel                             `tfsdk:"password_recipe"`
 }
 
 type PasswordRecipeModel struct {
-	Length  types.Int64 `tfsdk:"length"`
-	Digits  types.Bool  `tfsdk:"digits"`
-	Symbols types.Bool  `tfsdk:"symbols"`
+	Type              types.String `tfsdk:"type"`
+	Length            types.Int64  `tfsdk:"length"`
+	Digits            types.Bool   `tfsdk:"digits"`
+	Symbols           types.Bool   `tfsdk:"symbols"`
+	Letters           types.Bool   `tfsdk:"letters"`
+	ExcludeCharacters types.String `tfsdk:"exclude_characters"`
+	MinDigits         types.Int64  `tfsdk:"min_digits"`
+	MinSymbols        types.Int64  `tfsdk:"min_symbols"`
+	WordCount         types.Int64  `tfsdk:"word_count"`
+	Separator         types.String `tfsdk:"separator"`
+	Capitalize        types.Bool   `tfsdk:"capitalize"`
+	WordList          types.String `tfsdk:"word_list"`
 }
 
 // OnePasswordItemResourceSectionListModel is used for list-based sections
@@ -76,6 +121,7 @@ type OnePasswordItemResourceSectionListModel struct {
 	ID        types.String                        `tfsdk:"id"`
 	Label     types.String                        `tfsdk:"label"`
 	FieldList []OnePasswordItemResourceFieldModel `tfsdk:"field"`
+	FileList  []OnePasswordItemResourceFileModel  `tfsdk:"file"`
 }
 
 // OnePasswordItemResourceSectionMapModel is used for map-based sections
@@ -83,6 +129,30 @@ type OnePasswordItemResourceSectionListModel struct {
 type OnePasswordItemResourceSectionMapModel struct {
 	ID       types.String                                    `tfsdk:"id"`
 	FieldMap map[string]OnePasswordItemResourceFieldMapModel `tfsdk:"field_map"`
+	FileMap  map[string]OnePasswordItemResourceFileMapModel  `tfsdk:"file_map"`
+}
+
+// OnePasswordItemResourceFileModel is used for list-based files (file block)
+// Only the size and hash of the content are stored in state.
+type OnePasswordItemResourceFileModel struct {
+	ID              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	ContentWO       types.String `tfsdk:"content_wo"`
+	ContentBase64WO types.String `tfsdk:"content_base64_wo"`
+	Source          types.String `tfsdk:"source"`
+	Size            types.Int64  `tfsdk:"size"`
+	ContentHash     types.String `tfsdk:"content_hash"`
+}
+
+// OnePasswordItemResourceFileMapModel is used for map-based files (file_map attribute)
+// The map key serves as the file name
+type OnePasswordItemResourceFileMapModel struct {
+	ID              types.String `tfsdk:"id"`
+	ContentWO       types.String `tfsdk:"content_wo"`
+	ContentBase64WO types.String `tfsdk:"content_base64_wo"`
+	Source          types.String `tfsdk:"source"`
+	Size            types.Int64  `tfsdk:"size"`
+	ContentHash     types.String `tfsdk:"content_hash"`
 }
 
 // OnePasswordItemResourceFieldMapModel is used for map-based fields (field_map attribute)
@@ -114,29 +184,7 @@ func (r *OnePasswordItemResource) Schema(ctx context.Context, req resource.Schem
 			listvalidator.SizeAtMost(1),
 		},
 		NestedObject: schema.NestedBlockObject{
-			Attributes: map[string]schema.Attribute{
-				"length": schema.Int64Attribute{
-					MarkdownDescription: passwordLengthDescription,
-					Optional:            true,
-					Computed:            true,
-					Default:             int64default.StaticInt64(32),
-					Validators: []validator.Int64{
-						int64validator.Between(1, 64),
-					},
-				},
-				"digits": schema.BoolAttribute{
-					MarkdownDescription: passwordDigitsDescription,
-					Optional:            true,
-					Computed:            true,
-					Default:             booldefault.StaticBool(true),
-				},
-				"symbols": schema.BoolAttribute{
-					MarkdownDescription: passwordSymbolsDescription,
-					Optional:            true,
-					Computed:            true,
-					Default:             booldefault.StaticBool(true),
-				},
-			},
+			Attributes: passwordRecipeAttributes(),
 		},
 	}
 
@@ -190,39 +238,26 @@ func (r *OnePasswordItemResource) Schema(ctx context.Context, req resource.Schem
 						"password_recipe": schema.SingleNestedAttribute{
 							MarkdownDescription: passwordRecipeDescription,
 							Optional:            true,
-							Attributes: map[string]schema.Attribute{
-								"length": schema.Int64Attribute{
-									MarkdownDescription: passwordLengthDescription,
-									Optional:            true,
-									Computed:            true,
-									Default:             int64default.StaticInt64(32),
-									Validators: []validator.Int64{
-										int64validator.Between(1, 64),
-									},
-								},
-								"digits": schema.BoolAttribute{
-									MarkdownDescription: passwordDigitsDescription,
-									Optional:            true,
-									Computed:            true,
-									Default:             booldefault.StaticBool(true),
-								},
-								"symbols": schema.BoolAttribute{
-									MarkdownDescription: passwordSymbolsDescription,
-									Optional:            true,
-									Computed:            true,
-									Default:             booldefault.StaticBool(true),
-								},
-							},
+							Attributes:          passwordRecipeAttributes(),
 						},
 					},
 				},
 			},
+			"file_map": schema.MapNestedAttribute{
+				MarkdownDescription: fileMapDescription,
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: sectionFileAttributes(),
+				},
+			},
 		},
 	}
 
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
 		MarkdownDescription: "A 1Password Item.",
+		// Version 1 added password recipe attributes with defaults, see UpgradeState.
+		Version: 1,
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -242,6 +277,40 @@ func (r *OnePasswordItemResource) Schema(ctx context.Context, req resource.Schem
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"version": schema.Int64Attribute{
+				MarkdownDescription: itemVersionDescription,
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: itemCreatedAtDescription,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: itemUpdatedAtDescription,
+				Computed:            true,
+			},
+			"last_edited_by": schema.StringAttribute{
+				MarkdownDescription: itemLastEditedByDescription,
+				Computed:            true,
+			},
+			"favorite": schema.BoolAttribute{
+				MarkdownDescription: itemFavoriteDescription,
+				Computed:            true,
+			},
+			"conflict_detection": schema.BoolAttribute{
+				MarkdownDescription: conflictDetectionDescription,
+				Optional:            true,
+			},
+			"field_ownership": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf(enumDescription, fieldOwnershipDescription, fieldOwnerships),
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(fieldOwnerships...),
+				},
+			},
 			"vault": schema.StringAttribute{
 				MarkdownDescription: vaultUUIDDescription,
 				Required:            true,
@@ -266,7 +335,7 @@ func (r *OnePasswordItemResource) Schema(ctx context.Context, req resource.Schem
 				Optional:            true,
 			},
 			"url": schema.StringAttribute{
-				MarkdownDescription: urlDescription,
+				MarkdownDescription: urlDescription + " Cannot be used together with `website`.",
 				Optional:            true,
 			},
 			"hostname": schema.StringAttribute{
@@ -282,11 +351,8 @@ func (r *OnePasswordItemResource) Schema(ctx context.Context, req resource.Schem
 				Optional:            true,
 			},
 			"type": schema.StringAttribute{
-				MarkdownDescription: fmt.Sprintf(enumDescription, dbTypeDescription, dbTypes),
+				MarkdownDescription: fmt.Sprintf("%s For the database category, one of %q", typeDescription, dbTypes),
 				Optional:            true,
-				Validators: []validator.String{
-					stringvalidator.OneOfCaseInsensitive(dbTypes...),
-				},
 			},
 			"tags": schema.ListAttribute{
 				MarkdownDescription: tagsDescription,
@@ -364,6 +430,157 @@ func (r *OnePasswordItemResource) Schema(ctx context.Context, req resource.Schem
 					),
 				},
 			},
+			"credential": schema.StringAttribute{
+				MarkdownDescription: credentialDescription,
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"credential_wo": schema.StringAttribute{
+				MarkdownDescription: credentialWriteOnceDescription,
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(
+						path.Expressions{path.MatchRoot("credential")}...,
+					),
+					stringvalidator.AlsoRequires(
+						path.Expressions{path.MatchRoot("credential_wo_version")}...,
+					),
+				},
+			},
+			"credential_wo_version": schema.Int64Attribute{
+				MarkdownDescription: credentialWriteOnceVersionDescription,
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(
+						path.Expressions{path.MatchRoot("credential_wo")}...,
+					),
+				},
+			},
+			"valid_from": schema.StringAttribute{
+				MarkdownDescription: validFromDescription + " The date is in the format `YYYY-MM-DD`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(datePattern, "must be a date in the format YYYY-MM-DD"),
+				},
+			},
+			"expires": schema.StringAttribute{
+				MarkdownDescription: expiresDescription,
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(datePattern, "must be a date in the format YYYY-MM-DD"),
+				},
+			},
+			"filename": schema.StringAttribute{
+				MarkdownDescription: itemFilenameDescription,
+				Optional:            true,
+			},
+			"content": schema.StringAttribute{
+				MarkdownDescription: documentContentDescription,
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(
+						path.Expressions{path.MatchRoot("content_base64"), path.MatchRoot("source")}...,
+					),
+				},
+			},
+			"content_base64": schema.StringAttribute{
+				MarkdownDescription: documentContentBase64Description,
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(
+						path.Expressions{path.MatchRoot("source")}...,
+					),
+				},
+			},
+			"source": schema.StringAttribute{
+				MarkdownDescription: documentSourceDescription,
+				Optional:            true,
+			},
+			"content_hash": schema.StringAttribute{
+				MarkdownDescription: documentContentHashDescription,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					ContentHashModifier(documentContentAttributes),
+				},
+			},
+			"category_fields": schema.MapAttribute{
+				MarkdownDescription: categoryFieldsDescription,
+				ElementType:         types.StringType,
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"ssh_key_algorithm": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf(enumDescription, sshKeyAlgorithmDescription, sshKeyAlgorithms),
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(sshKeyAlgorithms...),
+					stringvalidator.ConflictsWith(
+						path.Expressions{path.MatchRoot("private_key_wo")}...,
+					),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ssh_key_rsa_bits": schema.Int64Attribute{
+				MarkdownDescription: sshKeyRSABitsDescription,
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.OneOf(opssh.RSABitSizes...),
+					int64validator.AlsoRequires(
+						path.Expressions{path.MatchRoot("ssh_key_algorithm")}...,
+					),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"private_key_wo": schema.StringAttribute{
+				MarkdownDescription: privateKeyWriteOnceDescription,
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(
+						path.Expressions{path.MatchRoot("private_key_wo_version")}...,
+					),
+				},
+			},
+			"private_key_wo_version": schema.Int64Attribute{
+				MarkdownDescription: privateKeyWriteOnceVersionDescription,
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(
+						path.Expressions{path.MatchRoot("private_key_wo")}...,
+					),
+				},
+			},
+			"public_key": schema.StringAttribute{
+				MarkdownDescription: sshKeyPublicKeyDescription,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					SSHKeyValueModifier(),
+				},
+			},
+			"fingerprint": schema.StringAttribute{
+				MarkdownDescription: sshKeyFingerprintDescription,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					SSHKeyValueModifier(),
+				},
+			},
+			"private_key_openssh": schema.StringAttribute{
+				MarkdownDescription: sshKeyPrivateKeyOpenSSHDescription,
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					SSHKeyValueModifier(),
+				},
+			},
 			"section_map": schema.MapNestedAttribute{
 				MarkdownDescription: sectionMapDescription,
 				Optional:            true,
@@ -371,6 +588,37 @@ func (r *OnePasswordItemResource) Schema(ctx context.Context, req resource.Schem
 			},
 		},
 		Blocks: map[string]schema.Block{
+			"website": schema.ListNestedBlock{
+				MarkdownDescription: websiteListDescription,
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"url": schema.StringAttribute{
+							MarkdownDescription: websiteURLDescription,
+							Required:            true,
+						},
+						"label": schema.StringAttribute{
+							MarkdownDescription: websiteLabelDescription,
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString("website"),
+						},
+						"primary": schema.BoolAttribute{
+							MarkdownDescription: websitePrimaryDescription,
+							Optional:            true,
+							Computed:            true,
+						},
+						"autofill_behavior": schema.StringAttribute{
+							MarkdownDescription: fmt.Sprintf(enumDescription, websiteAutofillBehaviorDescription, autofillBehaviors),
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString(strings.ToLower(string(model.AutofillBehaviorAnywhereOnWebsite))),
+							Validators: []validator.String{
+								stringvalidator.OneOfCaseInsensitive(autofillBehaviors...),
+							},
+						},
+					},
+				},
+			},
 			"section": schema.ListNestedBlock{
 				MarkdownDescription: sectionListDescription,
 				NestedObject: schema.NestedBlockObject{
@@ -435,6 +683,12 @@ func (r *OnePasswordItemResource) Schema(ctx context.Context, req resource.Schem
 								},
 							},
 						},
+						"file": schema.ListNestedBlock{
+							MarkdownDescription: fileListDescription,
+							NestedObject: schema.NestedBlockObject{
+								Attributes: sectionFileListAttributes(),
+							},
+						},
 					},
 				},
 			},
@@ -490,9 +744,310 @@ func (r *OnePasswordItemResource) ValidateConfig(ctx context.Context, req resour
 			"Cannot use both 'section' (list) and 'section_map' (map) at the same time. Please use only one of them.",
 		)
 	}
+
+	resp.Diagnostics.Append(validateSSHKeyConfig(ctx, req.Config)...)
+	resp.Diagnostics.Append(validateDatabaseTypeConfig(ctx, req.Config)...)
+	resp.Diagnostics.Append(validateDocumentConfig(ctx, req.Config)...)
+	resp.Diagnostics.Append(validateCategoryFieldsConfig(ctx, req.Config)...)
+	resp.Diagnostics.Append(validateWebsiteConfig(ctx, req.Config)...)
+	resp.Diagnostics.Append(validatePasswordRecipeConfig(ctx, req.Config)...)
+}
+
+// validatePasswordRecipeConfig checks that a password can be generated for every password recipe of the item.
+func validatePasswordRecipeConfig(ctx context.Context, config tfsdk.Config) diag.Diagnostics {
+	var recipeList, sectionList types.List
+	var sectionMap types.Map
+
+	diagnostics := config.GetAttribute(ctx, path.Root("password_recipe"), &recipeList)
+	diagnostics.Append(config.GetAttribute(ctx, path.Root("section"), &sectionList)...)
+	diagnostics.Append(config.GetAttribute(ctx, path.Root("section_map"), &sectionMap)...)
+	if diagnostics.HasError() {
+		return diagnostics
+	}
+
+	if !recipeList.IsUnknown() {
+		var recipes []PasswordRecipeModel
+		diagnostics.Append(recipeList.ElementsAs(ctx, &recipes, false)...)
+		for i, recipe := range recipes {
+			diagnostics.Append(validatePasswordRecipe(path.Root("password_recipe").AtListIndex(i), recipe)...)
+		}
+	}
+
+	if !sectionList.IsUnknown() {
+		var sections []OnePasswordItemResourceSectionListModel
+		diagnostics.Append(sectionList.ElementsAs(ctx, &sections, false)...)
+		for i, section := range sections {
+			for j, field := range section.FieldList {
+				for k, recipe := range field.Recipe {
+					recipePath := path.Root("section").AtListIndex(i).AtName("field").AtListIndex(j).AtName("password_recipe").AtListIndex(k)
+					diagnostics.Append(validatePasswordRecipe(recipePath, recipe)...)
+				}
+			}
+		}
+	}
+
+	if !sectionMap.IsUnknown() {
+		var sections map[string]OnePasswordItemResourceSectionMapModel
+		diagnostics.Append(sectionMap.ElementsAs(ctx, &sections, false)...)
+		for sectionLabel, section := range sections {
+			for fieldLabel, field := range section.FieldMap {
+				if field.Recipe == nil {
+					continue
+				}
+				recipePath := path.Root("section_map").AtMapKey(sectionLabel).AtName("field_map").AtMapKey(fieldLabel).AtName("password_recipe")
+				diagnostics.Append(validatePasswordRecipe(recipePath, *field.Recipe)...)
+			}
+		}
+	}
+
+	return diagnostics
+}
+
+// validatePasswordRecipe checks a password recipe of the configuration once its values are known.
+func validatePasswordRecipe(recipePath path.Path, recipe PasswordRecipeModel) diag.Diagnostics {
+	var diagnostics diag.Diagnostics
+	if recipe.Type.IsUnknown() || recipe.Length.IsUnknown() || recipe.Digits.IsUnknown() || recipe.Symbols.IsUnknown() ||
+		recipe.Letters.IsUnknown() || recipe.ExcludeCharacters.IsUnknown() || recipe.MinDigits.IsUnknown() || recipe.MinSymbols.IsUnknown() {
+		return diagnostics
+	}
+
+	// Defaults aren't applied to the configuration yet
+	if recipe.Digits.IsNull() {
+		recipe.Digits = types.BoolValue(true)
+	}
+	if recipe.Symbols.IsNull() {
+		recipe.Symbols = types.BoolValue(true)
+	}
+
+	if _, err := parseGeneratorRecipeFromModel(&recipe); err != nil {
+		diagnostics.AddAttributeError(recipePath, "Invalid Password Recipe", err.Error())
+	}
+	return diagnostics
+}
+
+// validateWebsiteConfig checks that `url` and `website` are not used together and that at most one website is primary.
+func validateWebsiteConfig(ctx context.Context, config tfsdk.Config) diag.Diagnostics {
+	var url types.String
+	var websiteList types.List
+	var websites []OnePasswordItemWebsiteModel
+
+	diagnostics := config.GetAttribute(ctx, path.Root("url"), &url)
+	diagnostics.Append(config.GetAttribute(ctx, path.Root("website"), &websiteList)...)
+	if diagnostics.HasError() || websiteList.IsUnknown() {
+		return diagnostics
+	}
+
+	diagnostics.Append(websiteList.ElementsAs(ctx, &websites, false)...)
+	if diagnostics.HasError() {
+		return diagnostics
+	}
+
+	if !url.IsNull() && len(websites) > 0 {
+		diagnostics.AddAttributeError(
+			path.Root("url"),
+			"Conflicting Website Definitions",
+			"Cannot use both 'url' and 'website' at the same time. Set the primary website with 'primary' in a 'website' block instead.",
+		)
+	}
+
+	primaryCount := 0
+	for _, w := range websites {
+		if w.Primary.ValueBool() {
+			primaryCount++
+		}
+	}
+	if primaryCount > 1 {
+		diagnostics.AddAttributeError(
+			path.Root("website"),
+			"Multiple Primary Websites",
+			"Only one 'website' block can be marked as primary.",
+		)
+	}
+
+	return diagnostics
+}
+
+// validateCategoryFieldsConfig checks that the built-in fields are only set for categories without dedicated attributes
+// and that they are built-in fields of the category with values in the format of their type.
+func validateCategoryFieldsConfig(ctx context.Context, config tfsdk.Config) diag.Diagnostics {
+	var category types.String
+	var categoryFields types.Map
+
+	diagnostics := config.GetAttribute(ctx, path.Root("category"), &category)
+	diagnostics.Append(config.GetAttribute(ctx, path.Root("category_fields"), &categoryFields)...)
+	if diagnostics.HasError() || category.IsUnknown() || categoryFields.IsUnknown() || categoryFields.IsNull() {
+		return diagnostics
+	}
+
+	itemCategory := model.ItemCategory(strings.ToUpper(category.ValueString()))
+	builtInFields, ok := model.CategoryFields(itemCategory)
+	if !ok {
+		diagnostics.AddAttributeError(
+			path.Root("category_fields"),
+			"Invalid Category Fields Configuration",
+			fmt.Sprintf("'category_fields' cannot be used with the '%s' category; use its dedicated attributes instead.", category.ValueString()),
+		)
+		return diagnostics
+	}
+	if len(builtInFields) == 0 {
+		diagnostics.AddAttributeError(
+			path.Root("category_fields"),
+			"Invalid Category Fields Configuration",
+			fmt.Sprintf("'category_fields' isn't supported for the '%s' category yet; use 'section' or 'section_map' to manage its fields.", category.ValueString()),
+		)
+		return diagnostics
+	}
+
+	fieldIDs := make([]string, 0, len(builtInFields))
+	for _, f := range builtInFields {
+		fieldIDs = append(fieldIDs, f.ID)
+	}
+
+	for id, element := range categoryFields.Elements() {
+		fieldPath := path.Root("category_fields").AtMapKey(id)
+
+		field, ok := model.FindCategoryField(itemCategory, id)
+		if !ok {
+			diagnostics.AddAttributeError(
+				fieldPath,
+				"Invalid Category Field",
+				fmt.Sprintf("'%s' is not a built-in field of the '%s' category, expected one of: %q", id, category.ValueString(), fieldIDs),
+			)
+			continue
+		}
+
+		value, ok := element.(types.String)
+		if !ok || value.IsUnknown() || value.IsNull() || value.ValueString() == "" {
+			continue
+		}
+
+		switch field.Type {
+		case model.FieldTypeMonthYear:
+			if _, err := time.Parse("200601", value.ValueString()); err != nil {
+				diagnostics.AddAttributeError(
+					fieldPath,
+					"Invalid MONTH_YEAR format",
+					fmt.Sprintf("MONTH_YEAR values must be in YYYYMM format with valid month 01-12 (e.g., 202401), got: %s", value.ValueString()),
+				)
+			}
+		case model.FieldTypeDate:
+			if !datePattern.MatchString(value.ValueString()) {
+				diagnostics.AddAttributeError(
+					fieldPath,
+					"Invalid DATE format",
+					fmt.Sprintf("DATE values must be in YYYY-MM-DD format, got: %s", value.ValueString()),
+				)
+			}
+		}
+	}
+
+	return diagnostics
+}
+
+// validateDocumentConfig checks that document items set a filename and exactly one content source
+// and that the content attributes are only used with the document category.
+func validateDocumentConfig(ctx context.Context, config tfsdk.Config) diag.Diagnostics {
+	var category, filename, content, contentBase64, source types.String
+
+	diagnostics := config.GetAttribute(ctx, path.Root("category"), &category)
+	diagnostics.Append(config.GetAttribute(ctx, path.Root("filename"), &filename)...)
+	diagnostics.Append(config.GetAttribute(ctx, path.Root("content"), &content)...)
+	diagnostics.Append(config.GetAttribute(ctx, path.Root("content_base64"), &contentBase64)...)
+	diagnostics.Append(config.GetAttribute(ctx, path.Root("source"), &source)...)
+	if diagnostics.HasError() || category.IsUnknown() {
+		return diagnostics
+	}
+
+	isDocument := strings.EqualFold(category.ValueString(), string(model.Document))
+	hasContent := !content.IsNull() || !contentBase64.IsNull() || !source.IsNull()
+
+	if isDocument && !hasContent {
+		diagnostics.AddError(
+			"Missing Document Content",
+			"Items of the 'document' category require one of 'content', 'content_base64' or 'source'.",
+		)
+	}
+	if isDocument && filename.IsNull() {
+		diagnostics.AddAttributeError(
+			path.Root("filename"),
+			"Missing Document Filename",
+			"Items of the 'document' category require 'filename' to be set.",
+		)
+	}
+	if !isDocument && hasContent {
+		diagnostics.AddError(
+			"Invalid Document Configuration",
+			"'content', 'content_base64' and 'source' can only be used with the 'document' category.",
+		)
+	}
+
+	return diagnostics
+}
+
+// validateDatabaseTypeConfig checks that database items use one of the supported database types.
+// API credential items accept any type.
+func validateDatabaseTypeConfig(ctx context.Context, config tfsdk.Config) diag.Diagnostics {
+	var category, itemType types.String
+
+	diagnostics := config.GetAttribute(ctx, path.Root("category"), &category)
+	diagnostics.Append(config.GetAttribute(ctx, path.Root("type"), &itemType)...)
+	if diagnostics.HasError() || category.IsUnknown() || itemType.IsUnknown() || itemType.IsNull() {
+		return diagnostics
+	}
+
+	if !strings.EqualFold(category.ValueString(), string(model.Database)) {
+		return diagnostics
+	}
+
+	if !slices.ContainsFunc(dbTypes, func(dbType string) bool {
+		return strings.EqualFold(dbType, itemType.ValueString())
+	}) {
+		diagnostics.AddAttributeError(
+			path.Root("type"),
+			"Invalid Attribute Value Match",
+			fmt.Sprintf("Attribute type value must be one of: %q, got: %q", dbTypes, itemType.ValueString()),
+		)
+	}
+
+	return diagnostics
+}
+
+// validateSSHKeyConfig checks that SSH key items either generate or import a key
+// and that the SSH key attributes are only used with the SSH key category.
+func validateSSHKeyConfig(ctx context.Context, config tfsdk.Config) diag.Diagnostics {
+	var category, algorithm types.String
+	var privateKeyVersion types.Int64
+
+	diagnostics := config.GetAttribute(ctx, path.Root("category"), &category)
+	diagnostics.Append(config.GetAttribute(ctx, path.Root("ssh_key_algorithm"), &algorithm)...)
+	diagnostics.Append(config.GetAttribute(ctx, path.Root("private_key_wo_version"), &privateKeyVersion)...)
+	if diagnostics.HasError() || category.IsUnknown() || algorithm.IsUnknown() || privateKeyVersion.IsUnknown() {
+		return diagnostics
+	}
+
+	isSSHKey := strings.EqualFold(category.ValueString(), string(model.SSHKey))
+	hasKey := !algorithm.IsNull() || !privateKeyVersion.IsNull()
+
+	if isSSHKey && !hasKey {
+		diagnostics.AddError(
+			"Missing SSH Key",
+			"Items of the 'ssh_key' category require either 'ssh_key_algorithm' to generate a key or 'private_key_wo' to import one.",
+		)
+	}
+	if !isSSHKey && hasKey {
+		diagnostics.AddError(
+			"Invalid SSH Key Configuration",
+			"'ssh_key_algorithm' and 'private_key_wo' can only be used with the 'ssh_key' category.",
+		)
+	}
+
+	return diagnostics
 }
 
 func (r *OnePasswordItemResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, warn := warnOnRateLimit(ctx, &resp.Diagnostics)
+	defer warn()
+
 	var plan OnePasswordItemResourceModel
 	var config OnePasswordItemResourceModel
 
@@ -511,6 +1066,24 @@ func (r *OnePasswordItemResource) Create(ctx context.Context, req resource.Creat
 	// Handle write-only fields
 	handleWriteOnlyField(config.PasswordWOVersion, config.PasswordWO, &plan.Password)
 	handleWriteOnlyField(config.NoteValueWOVersion, config.NoteValueWO, &plan.NoteValue)
+	handleWriteOnlyField(config.PrivateKeyWOVersion, config.PrivateKeyWO, &plan.PrivateKeyOpenSSH)
+	handleWriteOnlyField(config.CredentialWOVersion, config.CredentialWO, &plan.Credential)
+
+	// Generate the SSH key unless one is imported
+	if plan.Category.ValueString() == strings.ToLower(string(model.SSHKey)) && plan.PrivateKeyWOVersion.IsNull() {
+		privateKey, err := opssh.GeneratePrivateKey(opssh.KeyAlgorithm(plan.SSHKeyAlgorithm.ValueString()), int(plan.SSHKeyRSABits.ValueInt64()))
+		if err != nil {
+			resp.Diagnostics.AddError("1Password Item create error", fmt.Sprintf("Error generating SSH key, got error %s", err))
+			return
+		}
+		plan.PrivateKeyOpenSSH = types.StringValue(privateKey)
+	}
+
+	files, diagnostics := resolveSectionFiles(&plan, config)
+	resp.Diagnostics.Append(diagnostics...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	item, diagnostics := stateToModel(ctx, plan)
 	resp.Diagnostics.Append(diagnostics...)
@@ -518,12 +1091,36 @@ func (r *OnePasswordItemResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
+	if item.Category == model.Document {
+		file, diagnostics := toModelDocumentFile(plan)
+		resp.Diagnostics.Append(diagnostics...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		item.Files = []model.ItemFile{*file}
+		plan.ContentHash = documentContentHash(file)
+	}
+
 	createdItem, err := r.client.CreateItem(ctx, item, item.VaultID)
 	if err != nil {
 		resp.Diagnostics.AddError("1Password Item create error", fmt.Sprintf("Error creating 1Password item, got error %s", err))
 		return
 	}
 
+	if len(files) > 0 {
+		uploadedItem, err := uploadSectionFiles(ctx, r.client, createdItem, files, nil)
+		if err != nil {
+			// Delete the item so the next apply doesn't create it a second time
+			if deleteErr := r.client.DeleteItem(ctx, createdItem, plan.Vault.ValueString()); deleteErr != nil {
+				resp.Diagnostics.AddError("1Password Item create error", fmt.Sprintf("Error uploading files to 1Password item, got error %s. Could not delete item '%s' from vault '%s' afterwards, got error %s", err, createdItem.ID, plan.Vault.ValueString(), deleteErr))
+				return
+			}
+			resp.Diagnostics.AddError("1Password Item create error", fmt.Sprintf("Error uploading files to 1Password item, got error %s", err))
+			return
+		}
+		createdItem = uploadedItem
+	}
+
 	resp.Diagnostics.Append(modelToState(ctx, createdItem, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -532,6 +1129,8 @@ func (r *OnePasswordItemResource) Create(ctx context.Context, req resource.Creat
 	// Once created, clear write-only fields from state
 	clearWriteOnlyFieldFromState(config.PasswordWOVersion, &plan.Password)
 	clearWriteOnlyFieldFromState(config.NoteValueWOVersion, &plan.NoteValue)
+	clearWriteOnlyFieldFromState(config.PrivateKeyWOVersion, &plan.PrivateKeyOpenSSH)
+	clearWriteOnlyFieldFromState(config.CredentialWOVersion, &plan.Credential)
 
 	// Write logs using the tflog package
 	// Documentation: https://terraform.io/plugin/log
@@ -542,6 +1141,9 @@ func (r *OnePasswordItemResource) Create(ctx context.Context, req resource.Creat
 }
 
 func (r *OnePasswordItemResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, warn := warnOnRateLimit(ctx, &resp.Diagnostics)
+	defer warn()
+
 	var state OnePasswordItemResourceModel
 
 	// Read Terraform prior state state into the model
@@ -556,7 +1158,7 @@ func (r *OnePasswordItemResource) Read(ctx context.Context, req resource.ReadReq
 	if err != nil {
 		// If the resource no longer exists, remove it from state
 		// The next Terraform plan will recreate the resource
-		if isNotFoundError(err) {
+		if errors.Is(err, util.ErrNotFound) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
@@ -569,15 +1171,32 @@ func (r *OnePasswordItemResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
+	// Hash the stored document so changes made outside of Terraform are detected
+	if item.Category == model.Document {
+		if file := documentFile(item); file != nil {
+			content, err := r.client.GetFileContent(ctx, file, itemUUID, vaultUUID)
+			if err != nil {
+				resp.Diagnostics.AddError("1Password Item read error", fmt.Sprintf("Could not read the document of item '%s' from vault '%s', got error: %s", itemUUID, vaultUUID, err))
+				return
+			}
+			state.ContentHash = types.StringValue(contentHash(content))
+		}
+	}
+
 	// Once read, clear write-only fields from state
 	clearWriteOnlyFieldFromState(state.PasswordWOVersion, &state.Password)
 	clearWriteOnlyFieldFromState(state.NoteValueWOVersion, &state.NoteValue)
+	clearWriteOnlyFieldFromState(state.PrivateKeyWOVersion, &state.PrivateKeyOpenSSH)
+	clearWriteOnlyFieldFromState(state.CredentialWOVersion, &state.Credential)
 
 	// Save updated state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (r *OnePasswordItemResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, warn := warnOnRateLimit(ctx, &resp.Diagnostics)
+	defer warn()
+
 	var plan OnePasswordItemResourceModel
 	var config OnePasswordItemResourceModel
 	var state OnePasswordItemResourceModel
@@ -600,6 +1219,14 @@ func (r *OnePasswordItemResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
+	// Fail before changing anything if the item was modified outside of Terraform since it was last read
+	if plan.ConflictDetection.ValueBool() {
+		resp.Diagnostics.Append(checkItemConflict(ctx, r.client, state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	// Handle all write-only fields
 	vaultUUID, itemUUID := vaultAndItemUUID(plan.ID.ValueString())
 	err := handleWriteOnlyFieldUpdates(&config, &state, &plan, func() (*model.Item, error) {
@@ -614,18 +1241,95 @@ func (r *OnePasswordItemResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
+	files, diagnostics := resolveSectionFiles(&plan, config)
+	resp.Diagnostics.Append(diagnostics...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Find the files that are no longer configured or changed, which are removed once the new files are uploaded
+	var staleFiles []model.ItemFile
+	trackedFiles := trackedSectionFiles(state)
+	if len(files) > 0 || len(trackedFiles) > 0 {
+		currentItem, err := r.client.GetItem(ctx, itemUUID, vaultUUID)
+		if err != nil {
+			resp.Diagnostics.AddError("1Password Item read error", fmt.Sprintf("Could not read item '%s' from vault '%s' to update its files, got error: %s", itemUUID, vaultUUID, err))
+			return
+		}
+		staleFiles = staleSectionFiles(currentItem, files, trackedFiles)
+	}
+
 	item, diagnostics := stateToModel(ctx, plan)
 	resp.Diagnostics.Append(diagnostics...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// Keep the fields, sections, tags and websites that were added outside of Terraform
+	if isManagedOnly(plan) {
+		currentItem, err := r.client.GetItem(ctx, itemUUID, vaultUUID)
+		if err != nil {
+			resp.Diagnostics.AddError("1Password Item read error", fmt.Sprintf("Could not read item '%s' from vault '%s' to keep its unmanaged fields, got error: %s", itemUUID, vaultUUID, err))
+			return
+		}
+		if unsupported := currentItem.UnsupportedFields(); len(unsupported) > 0 {
+			resp.Diagnostics.AddError("1Password Item update error", fmt.Sprintf("Could not keep the unmanaged fields of item '%s' in vault '%s' because the type of its fields %q isn't supported by the provider", itemUUID, vaultUUID, unsupported))
+			return
+		}
+		previousItem, diagnostics := stateToModel(ctx, state)
+		resp.Diagnostics.Append(diagnostics...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		item = model.MergeUnmanaged(currentItem, toManagedItem(ctx, item, plan), toManagedItem(ctx, previousItem, state))
+	}
+
+	// Let the client reject the update if the item is modified after the conflict check
+	if plan.ConflictDetection.ValueBool() {
+		item.Version = int(state.Version.ValueInt64())
+	}
+
+	// Keep the sections of the stale files until the files are removed
+	keptSections := keepStaleFileSections(item, staleFiles)
+
 	updatedItem, err := r.client.UpdateItem(ctx, item, plan.Vault.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("1Password Item update error", fmt.Sprintf("Could not update item '%s' from vault '%s', got error: %s", plan.UUID.ValueString(), plan.Vault.ValueString(), err))
 		return
 	}
 
+	if len(files) > 0 || len(staleFiles) > 0 {
+		updatedItem, err = uploadSectionFiles(ctx, r.client, updatedItem, files, staleFiles)
+		if err == nil {
+			updatedItem, err = removeSectionFiles(ctx, r.client, updatedItem, staleFiles)
+		}
+		if err == nil && len(keptSections) > 0 {
+			updatedItem.Sections = slices.DeleteFunc(updatedItem.Sections, func(s model.ItemSection) bool { return slices.Contains(keptSections, s.ID) })
+			updatedItem.Version = 0
+			updatedItem, err = r.client.UpdateItem(ctx, updatedItem, plan.Vault.ValueString())
+		}
+		if err != nil {
+			resp.Diagnostics.AddError("1Password Item update error", fmt.Sprintf("Could not update the files of item '%s' from vault '%s', got error: %s", plan.UUID.ValueString(), plan.Vault.ValueString(), err))
+			return
+		}
+	}
+
+	// Only upload the document again if its content or name changed
+	if updatedItem.Category == model.Document && (!plan.ContentHash.Equal(state.ContentHash) || !plan.Filename.Equal(state.Filename)) {
+		file, diagnostics := toModelDocumentFile(plan)
+		resp.Diagnostics.Append(diagnostics...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		updatedItem, err = r.client.ReplaceDocument(ctx, updatedItem, file, plan.Vault.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("1Password Item update error", fmt.Sprintf("Could not replace the document of item '%s' from vault '%s', got error: %s", plan.UUID.ValueString(), plan.Vault.ValueString(), err))
+			return
+		}
+		plan.ContentHash = documentContentHash(file)
+	}
+
 	resp.Diagnostics.Append(modelToState(ctx, updatedItem, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -634,12 +1338,17 @@ func (r *OnePasswordItemResource) Update(ctx context.Context, req resource.Updat
 	// Once updated, always clear write-only fields from state
 	clearWriteOnlyFieldFromState(config.PasswordWOVersion, &plan.Password)
 	clearWriteOnlyFieldFromState(config.NoteValueWOVersion, &plan.NoteValue)
+	clearWriteOnlyFieldFromState(config.PrivateKeyWOVersion, &plan.PrivateKeyOpenSSH)
+	clearWriteOnlyFieldFromState(config.CredentialWOVersion, &plan.Credential)
 
 	// Save updated plan into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 func (r *OnePasswordItemResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, warn := warnOnRateLimit(ctx, &resp.Diagnostics)
+	defer warn()
+
 	var state OnePasswordItemResourceModel
 
 	// Read Terraform prior state state into the model
@@ -662,6 +1371,57 @@ func (r *OnePasswordItemResource) Delete(ctx context.Context, req resource.Delet
 	}
 }
 
+// UpgradeState upgrades the state of items written before the password recipe attributes added in version 1.
+// That state holds null for the new attributes while the plan holds their defaults, so without the upgrade
+// ValueModifier would see a changed recipe and every recipe-backed password would be generated again.
+func (r *OnePasswordItemResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {StateUpgrader: upgradeItemStateV0},
+	}
+}
+
+// upgradeItemStateV0 reads version 0 state with the current schema, which leaves the added attributes null,
+// and sets the null attributes of the password recipes to their defaults.
+func upgradeItemStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	raw, err := req.RawState.UnmarshalWithOpts(resp.State.Schema.Type().TerraformType(ctx), tfprotov6.UnmarshalOpts{
+		ValueFromJSONOpts: tftypes.ValueFromJSONOpts{IgnoreUndefinedAttributes: true},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to upgrade item state", fmt.Sprintf("Could not read the previous state, got error: %s", err))
+		return
+	}
+
+	var data OnePasswordItemResourceModel
+	state := tfsdk.State{Schema: resp.State.Schema, Raw: raw}
+	resp.Diagnostics.Append(state.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for i := range data.Recipe {
+		data.Recipe[i] = data.Recipe[i].withDefaults()
+	}
+	for i := range data.SectionList {
+		for j := range data.SectionList[i].FieldList {
+			recipes := data.SectionList[i].FieldList[j].Recipe
+			for k := range recipes {
+				recipes[k] = recipes[k].withDefaults()
+			}
+		}
+	}
+	for _, section := range data.SectionMap {
+		for label, field := range section.FieldMap {
+			if field.Recipe != nil {
+				recipe := field.Recipe.withDefaults()
+				field.Recipe = &recipe
+				section.FieldMap[label] = field
+			}
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
 func (r *OnePasswordItemResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
@@ -676,40 +1436,44 @@ func vaultAndItemUUID(tfID string) (vaultUUID, itemUUID string) {
 	return elements[1], elements[3]
 }
 
-// isNotFoundError checks if an error indicates that a resource was not found.
-// Different client implementations return different error when item is not found:
-//   - Connect: "status 404: item ... not found"
-//   - SDK: "item couldn't be found" (when item doesn't exist)
-//   - SDK: "item is not in an active state" (when item was removed)
-func isNotFoundError(err error) bool {
-	if err == nil {
-		return false
+func modelToState(ctx context.Context, modelItem *model.Item, state *OnePasswordItemResourceModel) diag.Diagnostics {
+	if isManagedOnly(*state) {
+		modelItem = toManagedItem(ctx, modelItem, *state)
 	}
-	errMsg := strings.ToLower(err.Error())
-	return strings.Contains(errMsg, "404") ||
-		strings.Contains(errMsg, "not found") ||
-		strings.Contains(errMsg, "item couldn't be found") ||
-		strings.Contains(errMsg, "item is not in an active state")
-}
 
-func modelToState(ctx context.Context, modelItem *model.Item, state *OnePasswordItemResourceModel) diag.Diagnostics {
 	state.ID = setStringValue(itemTerraformID(modelItem))
 	state.UUID = setStringValue(modelItem.ID)
 	state.Vault = setStringValue(modelItem.VaultID)
 	state.Title = setStringValuePreservingEmpty(modelItem.Title, state.Title)
 	state.Category = setStringValue(strings.ToLower(string(modelItem.Category)))
+	state.Version = types.Int64Value(int64(modelItem.Version))
+	state.CreatedAt = setTimeValue(modelItem.CreatedAt)
+	state.UpdatedAt = setTimeValue(modelItem.UpdatedAt)
+	state.LastEditedBy = setStringValue(modelItem.LastEditedBy)
+	state.Favorite = types.BoolValue(modelItem.Favorite)
 
 	if len(state.SectionMap) > 0 {
 		state.SectionMap = toStateSectionsAndFieldsMap(modelItem, state.SectionMap)
 	} else {
-		state.SectionList = toStateSectionsAndFieldsList(modelItem.Sections, modelItem.Fields, state.SectionList)
+		state.SectionList = toStateSectionsAndFieldsList(modelItem.Sections, modelItem.Fields, modelItem.Files, state.SectionList)
 	}
 
-	toStateTopLevelFields(modelItem.Fields, state)
+	if _, ok := model.CategoryFields(modelItem.Category); ok {
+		diagnostics := toStateCategoryFields(ctx, modelItem, state)
+		if diagnostics.HasError() {
+			return diagnostics
+		}
+	} else {
+		toStateTopLevelFields(modelItem.Fields, state)
+	}
 
-	for _, u := range modelItem.URLs {
-		if u.Primary {
-			state.URL = setStringValuePreservingEmpty(u.URL, state.URL)
+	if len(state.Website) > 0 {
+		state.Website = toStateWebsites(modelItem.URLs, state.Website)
+	} else {
+		for _, u := range modelItem.URLs {
+			if u.Primary {
+				state.URL = setStringValuePreservingEmpty(u.URL, state.URL)
+			}
 		}
 	}
 
@@ -719,9 +1483,24 @@ func modelToState(ctx context.Context, modelItem *model.Item, state *OnePassword
 	}
 	state.Tags = tags
 
-	// Password is not set for secure notes
-	if modelItem.Category == model.SecureNote && state.Password.IsUnknown() {
-		state.Password = types.StringNull()
+	// Password is only set for logins, passwords and databases
+	switch modelItem.Category {
+	case model.Login, model.Password, model.Database:
+	default:
+		if state.Password.IsUnknown() {
+			state.Password = types.StringNull()
+		}
+	}
+
+	if modelItem.Category == model.Document {
+		if file := documentFile(modelItem); file != nil {
+			state.Filename = setStringValue(file.Name)
+		}
+	}
+
+	diagnostics = toStateSSHKey(modelItem, state)
+	if diagnostics.HasError() {
+		return diagnostics
 	}
 
 	return nil
@@ -732,12 +1511,7 @@ func stateToModel(ctx context.Context, state OnePasswordItemResourceModel) (*mod
 		ID:      state.UUID.ValueString(),
 		VaultID: state.Vault.ValueString(),
 		Title:   state.Title.ValueString(),
-		URLs: []model.ItemURL{
-			{
-				Primary: true,
-				URL:     state.URL.ValueString(),
-			},
-		},
+		URLs:    toModelURLs(state),
 	}
 
 	password := state.Password.ValueString()
@@ -762,6 +1536,29 @@ func stateToModel(ctx context.Context, state OnePasswordItemResourceModel) (*mod
 	case "secure_note":
 		modelItem.Category = model.SecureNote
 		modelItem.Fields = toModelSecureNoteFields(state)
+	case "api_credential":
+		modelItem.Category = model.APICredential
+		modelItem.Fields = toModelAPICredentialFields(state)
+	case "document":
+		modelItem.Category = model.Document
+		modelItem.Fields = toModelSecureNoteFields(state)
+	case "ssh_key":
+		modelItem.Category = model.SSHKey
+		fields, err := toModelSSHKeyFields(state)
+		if err != nil {
+			return nil, diag.Diagnostics{diag.NewErrorDiagnostic(
+				"Error parsing SSH key",
+				fmt.Sprintf("Failed to parse SSH private key, got error: %s", err),
+			)}
+		}
+		modelItem.Fields = fields
+	default:
+		modelItem.Category = model.ItemCategory(strings.ToUpper(state.Category.ValueString()))
+		fields, diagnostics := toModelCategoryFields(ctx, state, modelItem.Category)
+		if diagnostics.HasError() {
+			return nil, diagnostics
+		}
+		modelItem.Fields = fields
 	}
 
 	tags, diagnostics := toModelTags(ctx, state)
@@ -783,6 +1580,223 @@ func stateToModel(ctx context.Context, state OnePasswordItemResourceModel) (*mod
 	return modelItem, nil
 }
 
+// sectionFileAttributes returns the attributes of a file attached to a section.
+// The content is write-only; only its size and hash are stored in state.
+func sectionFileAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: fileIDDescription,
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				FileIDModifier(sectionFileContentAttributes),
+			},
+		},
+		"content_wo": schema.StringAttribute{
+			MarkdownDescription: fileContentWriteOnceDescription,
+			Optional:            true,
+			Sensitive:           true,
+			WriteOnly:           true,
+		},
+		"content_base64_wo": schema.StringAttribute{
+			MarkdownDescription: fileContentBase64WriteOnceDescription,
+			Optional:            true,
+			Sensitive:           true,
+			WriteOnly:           true,
+		},
+		"source": schema.StringAttribute{
+			MarkdownDescription: fileSourceDescription,
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.ExactlyOneOf(
+					path.MatchRelative().AtParent().AtName("content_wo"),
+					path.MatchRelative().AtParent().AtName("content_base64_wo"),
+				),
+			},
+		},
+		"size": schema.Int64Attribute{
+			MarkdownDescription: fileSizeDescription,
+			Computed:            true,
+			PlanModifiers: []planmodifier.Int64{
+				ContentSizeModifier(sectionFileContentAttributes),
+			},
+		},
+		"content_hash": schema.StringAttribute{
+			MarkdownDescription: fileContentHashDescription,
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				ContentHashModifier(sectionFileContentAttributes),
+			},
+		},
+	}
+}
+
+// sectionFileListAttributes returns the attributes of a file in the file block, which names the file explicitly.
+func sectionFileListAttributes() map[string]schema.Attribute {
+	attributes := sectionFileAttributes()
+	attributes["name"] = schema.StringAttribute{
+		MarkdownDescription: fileNameDescription,
+		Required:            true,
+	}
+	return attributes
+}
+
+// defaultPasswordRecipe holds the defaults of the password recipe attributes.
+var defaultPasswordRecipe = PasswordRecipeModel{
+	Type:              types.StringValue(strings.ToLower(string(model.RecipeTypeRandom))),
+	Length:            types.Int64Value(32),
+	Digits:            types.BoolValue(true),
+	Symbols:           types.BoolValue(true),
+	Letters:           types.BoolValue(true),
+	ExcludeCharacters: types.StringValue(""),
+	MinDigits:         types.Int64Value(0),
+	MinSymbols:        types.Int64Value(0),
+	WordCount:         types.Int64Value(4),
+	Separator:         types.StringValue(strings.ToLower(string(model.SeparatorHyphens))),
+	Capitalize:        types.BoolValue(false),
+	WordList:          types.StringValue(strings.ToLower(string(model.WordListFullWords))),
+}
+
+// withDefaults returns the recipe with its null attributes set to their defaults.
+func (r PasswordRecipeModel) withDefaults() PasswordRecipeModel {
+	d := defaultPasswordRecipe
+	if !r.Type.IsNull() {
+		d.Type = r.Type
+	}
+	if !r.Length.IsNull() {
+		d.Length = r.Length
+	}
+	if !r.Digits.IsNull() {
+		d.Digits = r.Digits
+	}
+	if !r.Symbols.IsNull() {
+		d.Symbols = r.Symbols
+	}
+	if !r.Letters.IsNull() {
+		d.Letters = r.Letters
+	}
+	if !r.ExcludeCharacters.IsNull() {
+		d.ExcludeCharacters = r.ExcludeCharacters
+	}
+	if !r.MinDigits.IsNull() {
+		d.MinDigits = r.MinDigits
+	}
+	if !r.MinSymbols.IsNull() {
+		d.MinSymbols = r.MinSymbols
+	}
+	if !r.WordCount.IsNull() {
+		d.WordCount = r.WordCount
+	}
+	if !r.Separator.IsNull() {
+		d.Separator = r.Separator
+	}
+	if !r.Capitalize.IsNull() {
+		d.Capitalize = r.Capitalize
+	}
+	if !r.WordList.IsNull() {
+		d.WordList = r.WordList
+	}
+	return d
+}
+
+// passwordRecipeAttributes returns the attributes shared by the `password_recipe` block and the `password_recipe` attribute of `field_map` entries.
+func passwordRecipeAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"type": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf(enumDescription, passwordTypeDescription, passwordRecipeTypes),
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString(defaultPasswordRecipe.Type.ValueString()),
+			Validators: []validator.String{
+				stringvalidator.OneOf(passwordRecipeTypes...),
+			},
+		},
+		"length": schema.Int64Attribute{
+			MarkdownDescription: passwordLengthDescription,
+			Optional:            true,
+			Computed:            true,
+			Default:             int64default.StaticInt64(defaultPasswordRecipe.Length.ValueInt64()),
+			Validators: []validator.Int64{
+				int64validator.Between(1, 64),
+			},
+		},
+		"digits": schema.BoolAttribute{
+			MarkdownDescription: passwordDigitsDescription,
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(defaultPasswordRecipe.Digits.ValueBool()),
+		},
+		"symbols": schema.BoolAttribute{
+			MarkdownDescription: passwordSymbolsDescription,
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(defaultPasswordRecipe.Symbols.ValueBool()),
+		},
+		"letters": schema.BoolAttribute{
+			MarkdownDescription: passwordLettersDescription,
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(defaultPasswordRecipe.Letters.ValueBool()),
+		},
+		"exclude_characters": schema.StringAttribute{
+			MarkdownDescription: passwordExcludeCharactersDescription,
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString(defaultPasswordRecipe.ExcludeCharacters.ValueString()),
+		},
+		"min_digits": schema.Int64Attribute{
+			MarkdownDescription: passwordMinDigitsDescription,
+			Optional:            true,
+			Computed:            true,
+			Default:             int64default.StaticInt64(defaultPasswordRecipe.MinDigits.ValueInt64()),
+			Validators: []validator.Int64{
+				int64validator.Between(0, 64),
+			},
+		},
+		"min_symbols": schema.Int64Attribute{
+			MarkdownDescription: passwordMinSymbolsDescription,
+			Optional:            true,
+			Computed:            true,
+			Default:             int64default.StaticInt64(defaultPasswordRecipe.MinSymbols.ValueInt64()),
+			Validators: []validator.Int64{
+				int64validator.Between(0, 64),
+			},
+		},
+		"word_count": schema.Int64Attribute{
+			MarkdownDescription: passwordWordCountDescription,
+			Optional:            true,
+			Computed:            true,
+			Default:             int64default.StaticInt64(defaultPasswordRecipe.WordCount.ValueInt64()),
+			Validators: []validator.Int64{
+				int64validator.Between(3, 15),
+			},
+		},
+		"separator": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf(enumDescription, passwordSeparatorDescription, passwordSeparators),
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString(defaultPasswordRecipe.Separator.ValueString()),
+			Validators: []validator.String{
+				stringvalidator.OneOf(passwordSeparators...),
+			},
+		},
+		"capitalize": schema.BoolAttribute{
+			MarkdownDescription: passwordCapitalizeDescription,
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(defaultPasswordRecipe.Capitalize.ValueBool()),
+		},
+		"word_list": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf(enumDescription, passwordWordListDescription, passwordWordLists),
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString(defaultPasswordRecipe.WordList.ValueString()),
+			Validators: []validator.String{
+				stringvalidator.OneOf(passwordWordLists...),
+			},
+		},
+	}
+}
+
 func parseGeneratorRecipeList(recipeObject []PasswordRecipeModel) (*model.GeneratorRecipe, error) {
 	if len(recipeObject) == 0 {
 		return nil, nil
@@ -795,23 +1809,74 @@ func addRecipe(f *model.ItemField, r *model.GeneratorRecipe) {
 	f.Recipe = r
 
 	// Check to see if the current value adheres to the recipe
+	if !valueMatchesRecipe(f.Value, r) {
+		f.Generate = true
+	}
+}
 
-	var recipeDigits, recipeSymbols bool
-	hasDigits, _ := regexp.MatchString("[0-9]", f.Value)
-	hasSymbols, _ := regexp.MatchString("[^a-zA-Z0-9]", f.Value)
+// memorableSeparatorPatterns matches the characters placed between the words of a memorable password.
+var memorableSeparatorPatterns = map[model.PasswordSeparator]*regexp.Regexp{
+	model.SeparatorHyphens:          regexp.MustCompile("-"),
+	model.SeparatorSpaces:           regexp.MustCompile(" "),
+	model.SeparatorPeriods:          regexp.MustCompile(`\.`),
+	model.SeparatorCommas:           regexp.MustCompile(","),
+	model.SeparatorUnderscores:      regexp.MustCompile("_"),
+	model.SeparatorDigits:           regexp.MustCompile("[0-9]"),
+	model.SeparatorDigitsAndSymbols: regexp.MustCompile("[^a-zA-Z]"),
+}
 
-	for _, s := range r.CharacterSets {
-		switch s {
-		case model.CharacterSetDigits:
-			recipeDigits = true
-		case model.CharacterSetSymbols:
-			recipeSymbols = true
+var (
+	lettersOnlyPattern = regexp.MustCompile("^[a-zA-Z]+$")
+	digitsOnlyPattern  = regexp.MustCompile("^[0-9]+$")
+	digitPattern       = regexp.MustCompile("[0-9]")
+	symbolPattern      = regexp.MustCompile("[^a-zA-Z0-9]")
+	datePattern        = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+)
+
+// valueMatchesRecipe reports whether value could have been generated with the given recipe.
+func valueMatchesRecipe(value string, r *model.GeneratorRecipe) bool {
+	switch r.Type {
+	case model.RecipeTypePin:
+		return len(value) == r.Length && digitsOnlyPattern.MatchString(value)
+	case model.RecipeTypeMemorable:
+		separator, ok := memorableSeparatorPatterns[r.Separator]
+		if !ok {
+			return false
+		}
+		words := separator.Split(value, -1)
+		if len(words) != r.WordCount {
+			return false
+		}
+		for _, word := range words {
+			if !lettersOnlyPattern.MatchString(word) {
+				return false
+			}
+		}
+		return (strings.ToLower(value) != value) == r.Capitalize
+	default:
+		hasLetters, _ := regexp.MatchString("[a-zA-Z]", value)
+		hasDigits, _ := regexp.MatchString("[0-9]", value)
+		hasSymbols, _ := regexp.MatchString("[^a-zA-Z0-9]", value)
+
+		// A set the excluded characters remove entirely can't appear in the value
+		_, digits, symbols := r.AllowedCharacters()
+		recipeDigits := digits != ""
+		recipeSymbols := symbols != ""
+
+		// Letters are only checked when excluded, so values generated before the option existed stay valid.
+		if r.ExcludeLetters && hasLetters {
+			return false
+		}
+		if strings.ContainsAny(value, r.ExcludeCharacters) {
+			return false
+		}
+		if len(digitPattern.FindAllString(value, -1)) < r.MinDigits ||
+			len(symbolPattern.FindAllString(value, -1)) < r.MinSymbols {
+			return false
 		}
-	}
 
-	if hasDigits != recipeDigits ||
-		hasSymbols != recipeSymbols ||
-		len(f.Value) != r.Length {
-		f.Generate = true
+		return hasDigits == recipeDigits &&
+			hasSymbols == recipeSymbols &&
+			len(value) == r.Length
 	}
 }
@@ -1,13 +1,23 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/1Password/connect-sdk-go/onepassword"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 
+	"github.com/1Password/terraform-provider-onepassword/v2/connectemulator"
 	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/model"
 )
 
@@ -128,6 +138,156 @@ func TestAccItemResourceSecureNote(t *testing.T) {
 	})
 }
 
+func TestAccItemResourceSSHKey(t *testing.T) {
+	expectedItem := generateSSHKeyItem()
+	expectedVault := model.Vault{
+		ID:          expectedItem.VaultID,
+		Name:        "VaultName",
+		Description: "This vault will be retrieved for testing",
+	}
+
+	testServer := setupTestServer(expectedItem, expectedVault, t)
+	defer testServer.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(testServer.URL) + testAccSSHKeyResourceConfig(expectedItem),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// verify local values
+					resource.TestCheckResourceAttr("onepassword_item.test-ssh-key", "title", expectedItem.Title),
+					resource.TestCheckResourceAttr("onepassword_item.test-ssh-key", "category", strings.ToLower(string(expectedItem.Category))),
+					resource.TestCheckResourceAttr("onepassword_item.test-ssh-key", "public_key", expectedItem.Fields[1].Value),
+					resource.TestCheckResourceAttrSet("onepassword_item.test-ssh-key", "fingerprint"),
+					resource.TestCheckResourceAttrSet("onepassword_item.test-ssh-key", "private_key_openssh"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccItemResourceAPICredential(t *testing.T) {
+	expectedItem := generateApiCredentialResourceItem()
+	expectedVault := model.Vault{
+		ID:          expectedItem.VaultID,
+		Name:        "VaultName",
+		Description: "This vault will be retrieved for testing",
+	}
+
+	testServer := setupTestServer(expectedItem, expectedVault, t)
+	defer testServer.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(testServer.URL) + testAccAPICredentialResourceConfig(expectedItem),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// verify local values
+					resource.TestCheckResourceAttr("onepassword_item.test-api-credential", "title", expectedItem.Title),
+					resource.TestCheckResourceAttr("onepassword_item.test-api-credential", "category", strings.ToLower(string(expectedItem.Category))),
+					resource.TestCheckResourceAttr("onepassword_item.test-api-credential", "username", expectedItem.Fields[0].Value),
+					resource.TestCheckResourceAttr("onepassword_item.test-api-credential", "credential", expectedItem.Fields[1].Value),
+					resource.TestCheckResourceAttr("onepassword_item.test-api-credential", "type", expectedItem.Fields[2].Value),
+					resource.TestCheckResourceAttr("onepassword_item.test-api-credential", "filename", expectedItem.Fields[3].Value),
+					resource.TestCheckResourceAttr("onepassword_item.test-api-credential", "valid_from", expectedItem.Fields[4].Value),
+					resource.TestCheckResourceAttr("onepassword_item.test-api-credential", "expires", expectedItem.Fields[5].Value),
+					resource.TestCheckResourceAttr("onepassword_item.test-api-credential", "hostname", expectedItem.Fields[6].Value),
+					resource.TestCheckNoResourceAttr("onepassword_item.test-api-credential", "password"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccItemResourceCreditCard(t *testing.T) {
+	expectedItem := generateCreditCardItem()
+	expectedVault := model.Vault{
+		ID:          expectedItem.VaultID,
+		Name:        "VaultName",
+		Description: "This vault will be retrieved for testing",
+	}
+
+	testServer := setupTestServer(expectedItem, expectedVault, t)
+	defer testServer.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProviderConfig(testServer.URL) + testAccCategoryFieldsResourceConfig(expectedItem, "login", `{ ccnum = "4111111111111111" }`),
+				ExpectError: regexp.MustCompile("Invalid Category Fields Configuration"),
+			},
+			{
+				Config:      testAccProviderConfig(testServer.URL) + testAccCategoryFieldsResourceConfig(expectedItem, "server", `{ url = "https://example.com" }`),
+				ExpectError: regexp.MustCompile("isn't supported for the 'server' category"),
+			},
+			{
+				Config:      testAccProviderConfig(testServer.URL) + testAccCategoryFieldsResourceConfig(expectedItem, "credit_card", `{ expiry = "2027-12" }`),
+				ExpectError: regexp.MustCompile("Invalid MONTH_YEAR format"),
+			},
+			{
+				Config:      testAccProviderConfig(testServer.URL) + testAccCategoryFieldsResourceConfig(expectedItem, "credit_card", `{ iban = "GB33BUKB20201555555555" }`),
+				ExpectError: regexp.MustCompile("Invalid Category Field"),
+			},
+			{
+				Config: testAccProviderConfig(testServer.URL) + testAccCategoryFieldsResourceConfig(expectedItem, "credit_card", fmt.Sprintf(`{
+    cardholder = "%s"
+    ccnum      = "%s"
+    cvv        = "%s"
+    expiry     = "%s"
+  }`, expectedItem.Fields[0].Value, expectedItem.Fields[1].Value, expectedItem.Fields[2].Value, expectedItem.Fields[3].Value)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// verify local values
+					resource.TestCheckResourceAttr("onepassword_item.test-category-fields", "title", expectedItem.Title),
+					resource.TestCheckResourceAttr("onepassword_item.test-category-fields", "category", "credit_card"),
+					resource.TestCheckResourceAttr("onepassword_item.test-category-fields", "category_fields.cardholder", expectedItem.Fields[0].Value),
+					resource.TestCheckResourceAttr("onepassword_item.test-category-fields", "category_fields.ccnum", expectedItem.Fields[1].Value),
+					resource.TestCheckResourceAttr("onepassword_item.test-category-fields", "category_fields.cvv", expectedItem.Fields[2].Value),
+					resource.TestCheckResourceAttr("onepassword_item.test-category-fields", "category_fields.expiry", expectedItem.Fields[3].Value),
+					resource.TestCheckNoResourceAttr("onepassword_item.test-category-fields", "password"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccItemResourceWebsites(t *testing.T) {
+	expectedItem := generateLoginItemWithWebsites()
+	expectedVault := model.Vault{
+		ID:          expectedItem.VaultID,
+		Name:        "VaultName",
+		Description: "This vault will be retrieved for testing",
+	}
+
+	testServer := setupTestServer(expectedItem, expectedVault, t)
+	defer testServer.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProviderConfig(testServer.URL) + testAccWebsitesResourceConfig(expectedItem, `url = "https://example.com"`),
+				ExpectError: regexp.MustCompile("Conflicting Website Definitions"),
+			},
+			{
+				Config: testAccProviderConfig(testServer.URL) + testAccWebsitesResourceConfig(expectedItem, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("onepassword_item.test-websites", "website.#", "2"),
+					resource.TestCheckResourceAttr("onepassword_item.test-websites", "website.0.url", expectedItem.URLs[0].URL),
+					resource.TestCheckResourceAttr("onepassword_item.test-websites", "website.0.label", expectedItem.URLs[0].Label),
+					resource.TestCheckResourceAttr("onepassword_item.test-websites", "website.0.primary", "false"),
+					resource.TestCheckResourceAttr("onepassword_item.test-websites", "website.1.url", expectedItem.URLs[1].URL),
+					resource.TestCheckResourceAttr("onepassword_item.test-websites", "website.1.primary", "true"),
+					resource.TestCheckResourceAttr("onepassword_item.test-websites", "website.1.autofill_behavior", "exact_domain"),
+					resource.TestCheckNoResourceAttr("onepassword_item.test-websites", "url"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccItemResourceWithSections(t *testing.T) {
 	expectedItem := generateItemWithSections()
 	expectedVault := model.Vault{
@@ -158,6 +318,53 @@ func TestAccItemResourceWithSections(t *testing.T) {
 	})
 }
 
+func TestAccItemResourceFieldOwnershipManagedOnly(t *testing.T) {
+	expectedItem := generateItemWithSections()
+	// Section and notes added in the 1Password app
+	expectedItem.Sections = append(expectedItem.Sections, model.ItemSection{ID: "5678", Label: "Added in app"})
+	expectedItem.Fields = append(expectedItem.Fields,
+		model.ItemField{
+			ID:           "34567",
+			Type:         model.FieldTypeConcealed,
+			Label:        "recovery code",
+			Value:        "ABCD-EFGH",
+			SectionID:    "5678",
+			SectionLabel: "Added in app",
+		},
+		model.ItemField{
+			ID:      "notesPlain",
+			Type:    model.FieldTypeString,
+			Label:   "notesPlain",
+			Purpose: model.FieldPurposeNotes,
+			Value:   "Added in app",
+		},
+	)
+	expectedVault := model.Vault{
+		ID:          expectedItem.VaultID,
+		Name:        "VaultName",
+		Description: "This vault will be retrieved for testing",
+	}
+
+	testServer := setupTestServer(expectedItem, expectedVault, t)
+	defer testServer.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(testServer.URL) + testAccFieldOwnershipResourceConfig(expectedItem),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("onepassword_item.test-database", "field_ownership", "managed_only"),
+					resource.TestCheckResourceAttr("onepassword_item.test-database", "section.#", "1"),
+					resource.TestCheckResourceAttr("onepassword_item.test-database", "section.0.label", expectedItem.Sections[0].Label),
+					resource.TestCheckResourceAttr("onepassword_item.test-database", "section.0.field.#", "1"),
+					resource.TestCheckNoResourceAttr("onepassword_item.test-database", "note_value"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccItemResourceDocument(t *testing.T) {
 	expectedItem := generateDocumentItem()
 	expectedVault := model.Vault{
@@ -174,7 +381,12 @@ func TestAccItemResourceDocument(t *testing.T) {
 		Steps: []resource.TestStep{
 			{
 				Config:      testAccProviderConfig(testServer.URL) + testAccDocumentResourceConfig(expectedItem),
-				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+				ExpectError: regexp.MustCompile("Missing Document Content"),
+			},
+			{
+				// Connect does not support uploading files
+				Config:      testAccProviderConfig(testServer.URL) + testAccDocumentWithContentResourceConfig(expectedItem),
+				ExpectError: regexp.MustCompile("uploading files is only supported when using service account or desktop app"),
 			},
 		},
 	})
@@ -502,6 +714,97 @@ resource "onepassword_item" "test-document" {
 }`, expectedItem.VaultID, expectedItem.Title, strings.ToLower(string(expectedItem.Category)))
 }
 
+func testAccDocumentWithContentResourceConfig(expectedItem *model.Item) string {
+	return fmt.Sprintf(`
+
+data "onepassword_vault" "acceptance-tests" {
+	uuid = "%s"
+}
+resource "onepassword_item" "test-document" {
+  vault = data.onepassword_vault.acceptance-tests.uuid
+  title = "%s"
+  category = "%s"
+  filename = "%s"
+  content = "ascii"
+}`, expectedItem.VaultID, expectedItem.Title, strings.ToLower(string(expectedItem.Category)), expectedItem.Files[0].Name)
+}
+
+func testAccSSHKeyResourceConfig(expectedItem *model.Item) string {
+	return fmt.Sprintf(`
+
+data "onepassword_vault" "acceptance-tests" {
+	uuid = "%s"
+}
+resource "onepassword_item" "test-ssh-key" {
+  vault = data.onepassword_vault.acceptance-tests.uuid
+  title = "%s"
+  category = "%s"
+  ssh_key_algorithm = "rsa"
+  ssh_key_rsa_bits = 2048
+}`, expectedItem.VaultID, expectedItem.Title, strings.ToLower(string(expectedItem.Category)))
+}
+
+func testAccAPICredentialResourceConfig(expectedItem *model.Item) string {
+	return fmt.Sprintf(`
+
+data "onepassword_vault" "acceptance-tests" {
+	uuid = "%s"
+}
+resource "onepassword_item" "test-api-credential" {
+  vault = data.onepassword_vault.acceptance-tests.uuid
+  title = "%s"
+  category = "%s"
+  username = "%s"
+  credential = "%s"
+  type = "%s"
+  filename = "%s"
+  valid_from = "%s"
+  expires = "%s"
+  hostname = "%s"
+}`, expectedItem.VaultID, expectedItem.Title, strings.ToLower(string(expectedItem.Category)), expectedItem.Fields[0].Value, expectedItem.Fields[1].Value, expectedItem.Fields[2].Value, expectedItem.Fields[3].Value, expectedItem.Fields[4].Value, expectedItem.Fields[5].Value, expectedItem.Fields[6].Value)
+}
+
+func testAccCategoryFieldsResourceConfig(expectedItem *model.Item, category, categoryFields string) string {
+	return fmt.Sprintf(`
+
+data "onepassword_vault" "acceptance-tests" {
+	uuid = "%s"
+}
+resource "onepassword_item" "test-category-fields" {
+  vault = data.onepassword_vault.acceptance-tests.uuid
+  title = "%s"
+  category = "%s"
+  category_fields = %s
+}`, expectedItem.VaultID, expectedItem.Title, category, categoryFields)
+}
+
+func testAccWebsitesResourceConfig(expectedItem *model.Item, extraAttributes string) string {
+	return fmt.Sprintf(`
+
+data "onepassword_vault" "acceptance-tests" {
+	uuid = "%s"
+}
+resource "onepassword_item" "test-websites" {
+  vault = data.onepassword_vault.acceptance-tests.uuid
+  title = "%s"
+  category = "login"
+  username = "%s"
+  password = "%s"
+  %s
+
+  website {
+    url = "%s"
+  }
+
+  website {
+    url               = "%s"
+    label             = "%s"
+    primary           = true
+    autofill_behavior = "exact_domain"
+  }
+}`, expectedItem.VaultID, expectedItem.Title, expectedItem.Fields[0].Value, expectedItem.Fields[1].Value, extraAttributes, expectedItem.URLs[0].URL, expectedItem.URLs[1].URL, expectedItem.URLs[1].Label)
+}
+
 func testAccResourceWithSectionsConfig(expectedItem *model.Item) string {
 	return fmt.Sprintf(`
 
@@ -529,3 +832,296 @@ resource "onepassword_item" "test-database" {
 		expectedItem.Fields[0].Value,
 	)
 }
+
+func testAccFieldOwnershipResourceConfig(expectedItem *model.Item) string {
+	return fmt.Sprintf(`
+
+data "onepassword_vault" "acceptance-tests" {
+	uuid = "%s"
+}
+resource "onepassword_item" "test-database" {
+  vault = data.onepassword_vault.acceptance-tests.uuid
+  title = "%s"
+  category = "%s"
+  field_ownership = "managed_only"
+  password_recipe {}
+  section {
+	label = "%s"
+	field {
+	  label = "%s"
+	  value = "%s"
+	}
+  }
+}`,
+		expectedItem.VaultID,
+		expectedItem.Title,
+		strings.ToLower(string(expectedItem.Category)),
+		expectedItem.Sections[0].Label,
+		expectedItem.Fields[0].Label,
+		expectedItem.Fields[0].Value,
+	)
+}
+
+func TestValueMatchesRecipe(t *testing.T) {
+	tests := map[string]struct {
+		value    string
+		recipe   *model.GeneratorRecipe
+		expected bool
+	}{
+		"random password matching recipe": {
+			value:    "abc123!@",
+			recipe:   &model.GeneratorRecipe{Length: 8, CharacterSets: []model.CharacterSet{model.CharacterSetDigits, model.CharacterSetSymbols}},
+			expected: true,
+		},
+		"random password with digits not in recipe": {
+			value:    "abcd1234",
+			recipe:   &model.GeneratorRecipe{Type: model.RecipeTypeRandom, Length: 8},
+			expected: false,
+		},
+		"random password with excluded characters": {
+			value:    "abc123!@",
+			recipe:   &model.GeneratorRecipe{Length: 8, CharacterSets: []model.CharacterSet{model.CharacterSetDigits, model.CharacterSetSymbols}, ExcludeCharacters: "@$"},
+			expected: false,
+		},
+		"random password with letters when excluded": {
+			value:    "1a2.3!4-",
+			recipe:   &model.GeneratorRecipe{Length: 8, CharacterSets: []model.CharacterSet{model.CharacterSetDigits, model.CharacterSetSymbols}, ExcludeLetters: true},
+			expected: false,
+		},
+		"random password without letters": {
+			value:    "1.2.3!4-",
+			recipe:   &model.GeneratorRecipe{Length: 8, CharacterSets: []model.CharacterSet{model.CharacterSetDigits, model.CharacterSetSymbols}, ExcludeLetters: true},
+			expected: true,
+		},
+		"random password without digits when all digits are excluded": {
+			value:    "abcd!@ef",
+			recipe:   &model.GeneratorRecipe{Length: 8, CharacterSets: []model.CharacterSet{model.CharacterSetDigits, model.CharacterSetSymbols}, ExcludeCharacters: "0123456789"},
+			expected: true,
+		},
+		"random password meeting minimum counts": {
+			value:    "ab12c!@d",
+			recipe:   &model.GeneratorRecipe{Length: 8, CharacterSets: []model.CharacterSet{model.CharacterSetDigits, model.CharacterSetSymbols}, MinDigits: 2, MinSymbols: 2},
+			expected: true,
+		},
+		"random password below minimum digits": {
+			value:    "abc1d!@e",
+			recipe:   &model.GeneratorRecipe{Length: 8, CharacterSets: []model.CharacterSet{model.CharacterSetDigits, model.CharacterSetSymbols}, MinDigits: 2},
+			expected: false,
+		},
+		"PIN matching recipe": {
+			value:    "123456",
+			recipe:   &model.GeneratorRecipe{Type: model.RecipeTypePin, Length: 6},
+			expected: true,
+		},
+		"PIN with wrong length": {
+			value:    "1234",
+			recipe:   &model.GeneratorRecipe{Type: model.RecipeTypePin, Length: 6},
+			expected: false,
+		},
+		"PIN with letters": {
+			value:    "12a456",
+			recipe:   &model.GeneratorRecipe{Type: model.RecipeTypePin, Length: 6},
+			expected: false,
+		},
+		"memorable password matching recipe": {
+			value:    "correct-horse-battery-staple",
+			recipe:   &model.GeneratorRecipe{Type: model.RecipeTypeMemorable, WordCount: 4, Separator: model.SeparatorHyphens},
+			expected: true,
+		},
+		"memorable password with digit separators": {
+			value:    "correct4horse7battery",
+			recipe:   &model.GeneratorRecipe{Type: model.RecipeTypeMemorable, WordCount: 3, Separator: model.SeparatorDigits},
+			expected: true,
+		},
+		"memorable password with wrong word count": {
+			value:    "correct-horse-battery",
+			recipe:   &model.GeneratorRecipe{Type: model.RecipeTypeMemorable, WordCount: 4, Separator: model.SeparatorHyphens},
+			expected: false,
+		},
+		"memorable password with wrong separator": {
+			value:    "correct.horse.battery.staple",
+			recipe:   &model.GeneratorRecipe{Type: model.RecipeTypeMemorable, WordCount: 4, Separator: model.SeparatorHyphens},
+			expected: false,
+		},
+		"memorable password missing capitalization": {
+			value:    "correct-horse-battery-staple",
+			recipe:   &model.GeneratorRecipe{Type: model.RecipeTypeMemorable, WordCount: 4, Separator: model.SeparatorHyphens, Capitalize: true},
+			expected: false,
+		},
+		"memorable password with capitalization": {
+			value:    "correct-HORSE-battery-staple",
+			recipe:   &model.GeneratorRecipe{Type: model.RecipeTypeMemorable, WordCount: 4, Separator: model.SeparatorHyphens, Capitalize: true},
+			expected: true,
+		},
+	}
+
+	for description, test := range tests {
+		t.Run(description, func(t *testing.T) {
+			actual := valueMatchesRecipe(test.value, test.recipe)
+			if actual != test.expected {
+				t.Errorf("valueMatchesRecipe(%q) = %v, want %v", test.value, actual, test.expected)
+			}
+		})
+	}
+}
+
+func TestAccItemResourceConnectEmulator(t *testing.T) {
+	e, testServer := setupConnectEmulator(t, connectemulator.Config{ConsistencyDelay: 100 * time.Millisecond})
+	production := e.AddVault(onepassword.Vault{Name: "Production"})
+	staging := e.AddVault(onepassword.Vault{Name: "Staging"})
+
+	config := func(username string) string {
+		return testAccProviderConfig(testServer.URL) + fmt.Sprintf(`
+resource "onepassword_item" "production" {
+  vault    = "%s"
+  title    = "Database"
+  category = "database"
+  username = "%s"
+  password_recipe {}
+}
+
+resource "onepassword_item" "staging" {
+  vault    = "%s"
+  title    = "Database"
+  category = "database"
+  username = "%s"
+  password_recipe {}
+}`, production.ID, username, staging.ID, username)
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("admin"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("onepassword_item.production", "username", "admin"),
+					resource.TestCheckResourceAttr("onepassword_item.staging", "username", "admin"),
+					resource.TestCheckResourceAttr("onepassword_item.production", "version", "1"),
+					func(s *terraform.State) error {
+						if len(e.Items(production.ID)) != 1 || len(e.Items(staging.ID)) != 1 {
+							return fmt.Errorf("expected one item in every vault")
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config: config("root"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("onepassword_item.production", "username", "root"),
+					resource.TestCheckResourceAttr("onepassword_item.production", "version", "2"),
+					resource.TestCheckResourceAttr("onepassword_item.staging", "version", "2"),
+				),
+			},
+			{
+				ResourceName:      "onepassword_item.staging",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"password_recipe",
+				},
+			},
+		},
+	})
+}
+
+func TestItemResourceUpgradeStateKeepsRecipePasswords(t *testing.T) {
+	ctx := context.Background()
+	server, err := providerserver.NewProtocol6WithError(New("test")())()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var schemaResp fwresource.SchemaResponse
+	NewOnePasswordItemResource().Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	itemType := schemaResp.Schema.Type().TerraformType(ctx)
+
+	// State written before version 1, which only had the length, digits and symbols recipe attributes
+	upgradeResp, err := server.UpgradeResourceState(ctx, &tfprotov6.UpgradeResourceStateRequest{
+		TypeName: "onepassword_item",
+		Version:  0,
+		RawState: &tfprotov6.RawState{JSON: []byte(`{
+			"id": "vaults/vault-uuid/items/item-uuid",
+			"uuid": "item-uuid",
+			"vault": "vault-uuid",
+			"category": "login",
+			"title": "Test",
+			"password": "aB3-xY7!qR9#tU2$",
+			"password_recipe": [{"length": 16, "digits": true, "symbols": true}],
+			"section": [{
+				"id": "section-id",
+				"label": "Credentials",
+				"field": [{
+					"id": "field-id",
+					"label": "PIN",
+					"type": "CONCEALED",
+					"value": "X7k2Pq9Z",
+					"password_recipe": [{"length": 8, "digits": true, "symbols": false}]
+				}]
+			}]
+		}`)},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(upgradeResp.Diagnostics) > 0 {
+		t.Fatalf("Unexpected diagnostics: %v", upgradeResp.Diagnostics[0].Detail)
+	}
+
+	// The configuration that wrote the state above
+	config := tfsdk.Plan{Schema: schemaResp.Schema}
+	diags := config.Set(ctx, &OnePasswordItemResourceModel{
+		Vault:          types.StringValue("vault-uuid"),
+		Title:          types.StringValue("Test"),
+		Tags:           types.ListNull(types.StringType),
+		CategoryFields: types.MapNull(types.StringType),
+		Recipe: []PasswordRecipeModel{{
+			Length: types.Int64Value(16),
+		}},
+		SectionList: []OnePasswordItemResourceSectionListModel{{
+			Label: types.StringValue("Credentials"),
+			FieldList: []OnePasswordItemResourceFieldModel{{
+				Label: types.StringValue("PIN"),
+				Type:  types.StringValue("CONCEALED"),
+				Recipe: []PasswordRecipeModel{{
+					Length:  types.Int64Value(8),
+					Symbols: types.BoolValue(false),
+				}},
+			}},
+		}},
+	})
+	if diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+	configValue, err := tfprotov6.NewDynamicValue(itemType, config.Raw)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// Nothing is changed in the configuration, so the proposed new state is the prior state
+	planResp, err := server.PlanResourceChange(ctx, &tfprotov6.PlanResourceChangeRequest{
+		TypeName:         "onepassword_item",
+		PriorState:       upgradeResp.UpgradedState,
+		ProposedNewState: upgradeResp.UpgradedState,
+		Config:           &configValue,
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(planResp.Diagnostics) > 0 {
+		t.Fatalf("Unexpected diagnostics: %v", planResp.Diagnostics[0].Detail)
+	}
+
+	prior, err := upgradeResp.UpgradedState.Unmarshal(itemType)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	planned, err := planResp.PlannedState.Unmarshal(itemType)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if diffs, _ := prior.Diff(planned); len(diffs) > 0 {
+		t.Errorf("Expected an empty plan, got changes to %s", diffs[0].Path)
+	}
+}
@@ -0,0 +1,252 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword"
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/model"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &OnePasswordItemsDataSource{}
+
+func NewOnePasswordItemsDataSource() datasource.DataSource {
+	return &OnePasswordItemsDataSource{}
+}
+
+// OnePasswordItemsDataSource defines the data source implementation.
+type OnePasswordItemsDataSource struct {
+	client onepassword.Client
+}
+
+// OnePasswordItemsDataSourceModel describes the data source data model.
+type OnePasswordItemsDataSourceModel struct {
+	Vault      types.String                `tfsdk:"vault"`
+	Category   types.String                `tfsdk:"category"`
+	Tag        types.String                `tfsdk:"tag"`
+	TitleRegex types.String                `tfsdk:"title_regex"`
+	URL        types.String                `tfsdk:"url"`
+	Items      []OnePasswordItemsItemModel `tfsdk:"items"`
+}
+
+// OnePasswordItemsItemModel describes a single item overview returned by the data source.
+type OnePasswordItemsItemModel struct {
+	ID        types.String `tfsdk:"id"`
+	UUID      types.String `tfsdk:"uuid"`
+	Title     types.String `tfsdk:"title"`
+	Category  types.String `tfsdk:"category"`
+	Tags      types.List   `tfsdk:"tags"`
+	URLs      types.List   `tfsdk:"urls"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+}
+
+// itemFilter holds the optional filters of the onepassword_items data source. Empty values match every item.
+type itemFilter struct {
+	category   model.ItemCategory
+	tag        string
+	titleRegex *regexp.Regexp
+	url        string
+}
+
+func (d *OnePasswordItemsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_items"
+}
+
+func (d *OnePasswordItemsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Use this data source to list the items in a vault, optionally filtered by category, tag, title or URL. Only item metadata is returned; field values are never read.",
+
+		Attributes: map[string]schema.Attribute{
+			"vault": schema.StringAttribute{
+				MarkdownDescription: vaultUUIDDescription,
+				Required:            true,
+			},
+			"category": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf(enumDescription, "Only return items of this category.", categories),
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOfCaseInsensitive(categories...),
+				},
+			},
+			"tag": schema.StringAttribute{
+				MarkdownDescription: "Only return items that have this tag.",
+				Optional:            true,
+			},
+			"title_regex": schema.StringAttribute{
+				MarkdownDescription: "Only return items whose title matches this regular expression (RE2 syntax).",
+				Optional:            true,
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "Only return items that have a website URL containing this value.",
+				Optional:            true,
+			},
+			"items": schema.ListNestedAttribute{
+				MarkdownDescription: "The items matching the filters, sorted by title.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The Terraform resource identifier for this item in the format `vaults/<vault_id>/items/<item_id>`.",
+							Computed:            true,
+						},
+						"uuid": schema.StringAttribute{
+							MarkdownDescription: "The UUID of the item.",
+							Computed:            true,
+						},
+						"title": schema.StringAttribute{
+							MarkdownDescription: "The title of the item.",
+							Computed:            true,
+						},
+						"category": schema.StringAttribute{
+							MarkdownDescription: "The category of the item.",
+							Computed:            true,
+						},
+						"tags": schema.ListAttribute{
+							MarkdownDescription: "The tags of the item.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"urls": schema.ListAttribute{
+							MarkdownDescription: "The website URLs of the item.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "The time the item was created, in RFC 3339 format.",
+							Computed:            true,
+						},
+						"updated_at": schema.StringAttribute{
+							MarkdownDescription: "The time the item was last updated, in RFC 3339 format.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *OnePasswordItemsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(onepassword.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected onepassword.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *OnePasswordItemsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, warn := warnOnRateLimit(ctx, &resp.Diagnostics)
+	defer warn()
+
+	var data OnePasswordItemsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := itemFilter{
+		category: model.ItemCategory(strings.ToUpper(data.Category.ValueString())),
+		tag:      data.Tag.ValueString(),
+		url:      data.URL.ValueString(),
+	}
+	if data.TitleRegex.ValueString() != "" {
+		titleRegex, err := regexp.Compile(data.TitleRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid title_regex", fmt.Sprintf("Unable to parse title_regex, got error: %s", err))
+			return
+		}
+		filter.titleRegex = titleRegex
+	}
+
+	items, err := d.client.ListItems(ctx, data.Vault.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list items in vault '%s', got error: %s", data.Vault.ValueString(), err))
+		return
+	}
+
+	items = filterItems(items, filter)
+
+	data.Items = make([]OnePasswordItemsItemModel, len(items))
+	for i, item := range items {
+		tags, diags := types.ListValueFrom(ctx, types.StringType, item.Tags)
+		resp.Diagnostics.Append(diags...)
+		urls, diags := types.ListValueFrom(ctx, types.StringType, item.URLs)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		data.Items[i] = OnePasswordItemsItemModel{
+			ID:        types.StringValue(itemTerraformID(&model.Item{ID: item.ID, VaultID: item.VaultID})),
+			UUID:      types.StringValue(item.ID),
+			Title:     types.StringValue(item.Title),
+			Category:  types.StringValue(strings.ToLower(string(item.Category))),
+			Tags:      tags,
+			URLs:      urls,
+			CreatedAt: setTimeValue(item.CreatedAt),
+			UpdatedAt: setTimeValue(item.UpdatedAt),
+		}
+	}
+
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// filterItems returns the items matching every filter, sorted by title.
+func filterItems(items []model.ItemOverview, filter itemFilter) []model.ItemOverview {
+	result := []model.ItemOverview{}
+	for _, item := range items {
+		if filter.category != "" && item.Category != filter.category {
+			continue
+		}
+		if filter.tag != "" && !slices.Contains(item.Tags, filter.tag) {
+			continue
+		}
+		if filter.titleRegex != nil && !filter.titleRegex.MatchString(item.Title) {
+			continue
+		}
+		if filter.url != "" && !slices.ContainsFunc(item.URLs, func(url string) bool {
+			return strings.Contains(url, filter.url)
+		}) {
+			continue
+		}
+		result = append(result, item)
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Title < result[j].Title
+	})
+
+	return result
+}
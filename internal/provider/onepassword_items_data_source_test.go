@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/model"
+)
+
+func TestAccItemsDataSource(t *testing.T) {
+	expectedItem := generateLoginItem()
+	expectedItem.Tags = []string{"prod"}
+	expectedVault := model.Vault{
+		ID:          expectedItem.VaultID,
+		Name:        "VaultName",
+		Description: "This vault will be listed",
+	}
+
+	testServer := setupTestServer(expectedItem, expectedVault, t)
+	defer testServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(testServer.URL) + testAccItemsDataSourceConfig(expectedItem.VaultID, "login", "prod"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.onepassword_items.test", "items.#", "1"),
+					resource.TestCheckResourceAttr("data.onepassword_items.test", "items.0.id", fmt.Sprintf("vaults/%s/items/%s", expectedItem.VaultID, expectedItem.ID)),
+					resource.TestCheckResourceAttr("data.onepassword_items.test", "items.0.uuid", expectedItem.ID),
+					resource.TestCheckResourceAttr("data.onepassword_items.test", "items.0.title", expectedItem.Title),
+					resource.TestCheckResourceAttr("data.onepassword_items.test", "items.0.category", "login"),
+					resource.TestCheckResourceAttr("data.onepassword_items.test", "items.0.tags.0", "prod"),
+					resource.TestCheckResourceAttr("data.onepassword_items.test", "items.0.urls.0", expectedItem.URLs[0].URL),
+				),
+			},
+			{
+				Config: testAccProviderConfig(testServer.URL) + testAccItemsDataSourceConfig(expectedItem.VaultID, "database", "prod"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.onepassword_items.test", "items.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestFilterItems(t *testing.T) {
+	items := []model.ItemOverview{
+		{ID: "1", Title: "prod-db", Category: model.Database, Tags: []string{"prod"}},
+		{ID: "2", Title: "dev-db", Category: model.Database, Tags: []string{"dev"}},
+		{ID: "3", Title: "prod-login", Category: model.Login, Tags: []string{"prod"}, URLs: []string{"https://example.com/login"}},
+	}
+
+	tests := map[string]struct {
+		filter      itemFilter
+		expectedIDs []string
+	}{
+		"should return all items sorted by title without filters": {
+			expectedIDs: []string{"2", "1", "3"},
+		},
+		"should filter by category": {
+			filter:      itemFilter{category: model.Database},
+			expectedIDs: []string{"2", "1"},
+		},
+		"should filter by tag": {
+			filter:      itemFilter{tag: "prod"},
+			expectedIDs: []string{"1", "3"},
+		},
+		"should filter by title regex": {
+			filter:      itemFilter{titleRegex: regexp.MustCompile("-db$")},
+			expectedIDs: []string{"2", "1"},
+		},
+		"should filter by url": {
+			filter:      itemFilter{url: "example.com"},
+			expectedIDs: []string{"3"},
+		},
+		"should combine filters": {
+			filter:      itemFilter{category: model.Database, tag: "prod"},
+			expectedIDs: []string{"1"},
+		},
+		"should return empty list when nothing matches": {
+			filter:      itemFilter{tag: "staging"},
+			expectedIDs: []string{},
+		},
+	}
+
+	for description, test := range tests {
+		t.Run(description, func(t *testing.T) {
+			filtered := filterItems(items, test.filter)
+			ids := make([]string, len(filtered))
+			for i, item := range filtered {
+				ids[i] = item.ID
+			}
+			if !reflect.DeepEqual(ids, test.expectedIDs) {
+				t.Errorf("Expected %v, got %v", test.expectedIDs, ids)
+			}
+		})
+	}
+}
+
+func testAccItemsDataSourceConfig(vault, category, tag string) string {
+	return fmt.Sprintf(`
+data "onepassword_items" "test" {
+  vault    = "%s"
+  category = "%s"
+  tag      = "%s"
+}`, vault, category, tag)
+}
@@ -95,6 +95,9 @@ func (d *OnePasswordVaultDataSource) Configure(ctx context.Context, req datasour
 }
 
 func (d *OnePasswordVaultDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, warn := warnOnRateLimit(ctx, &resp.Diagnostics)
+	defer warn()
+
 	var data OnePasswordVaultDataSourceModel
 
 	// Read Terraform configuration data into the model
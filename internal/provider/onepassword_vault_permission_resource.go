@@ -0,0 +1,314 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword"
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/model"
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/util"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &OnePasswordVaultPermissionResource{}
+var _ resource.ResourceWithImportState = &OnePasswordVaultPermissionResource{}
+
+func NewOnePasswordVaultPermissionResource() resource.Resource {
+	return &OnePasswordVaultPermissionResource{}
+}
+
+// OnePasswordVaultPermissionResource defines the resource implementation.
+type OnePasswordVaultPermissionResource struct {
+	client onepassword.Client
+}
+
+// OnePasswordVaultPermissionResourceModel describes the resource data model.
+type OnePasswordVaultPermissionResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Vault       types.String `tfsdk:"vault"`
+	Group       types.String `tfsdk:"group"`
+	Permissions types.Set    `tfsdk:"permissions"`
+}
+
+func (r *OnePasswordVaultPermissionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vault_permission"
+}
+
+func (r *OnePasswordVaultPermissionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Grants a 1Password group a set of permissions on a vault. Only group access can be managed; user access is not supported by the 1Password SDK. Managing vault permissions is only supported when using **service account** or **desktop app** authentication; it is not available with 1Password Connect.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The Terraform resource identifier for this permission in the format `vaults/<vault_id>/groups/<group_id>`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"vault": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the vault to grant access to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"group": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the group to grant access to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"permissions": schema.SetAttribute{
+				MarkdownDescription: fmt.Sprintf(enumDescription, "The permissions the group has on the vault.", model.VaultPermissionNames()),
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(model.VaultPermissionNames()...)),
+				},
+			},
+		},
+	}
+}
+
+func (r *OnePasswordVaultPermissionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(onepassword.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected onepassword.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *OnePasswordVaultPermissionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, warn := warnOnRateLimit(ctx, &resp.Diagnostics)
+	defer warn()
+
+	var plan OnePasswordVaultPermissionResourceModel
+
+	// Read Terraform plan into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	access, err := vaultPermissionPlanToModel(ctx, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError("1Password Vault Permission create error", err.Error())
+		return
+	}
+
+	err = r.client.GrantVaultGroupPermissions(ctx, *access)
+	if err != nil {
+		resp.Diagnostics.AddError("1Password Vault Permission create error", fmt.Sprintf("Error granting group '%s' access to vault '%s', got error %s", access.AccessorID, access.VaultID, err))
+		return
+	}
+
+	plan.ID = types.StringValue(vaultPermissionTerraformID(access.VaultID, access.AccessorID))
+
+	tflog.Trace(ctx, "created a vault permission resource")
+
+	// Save plan into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *OnePasswordVaultPermissionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, warn := warnOnRateLimit(ctx, &resp.Diagnostics)
+	defer warn()
+
+	var state OnePasswordVaultPermissionResourceModel
+
+	// Read Terraform prior state into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vaultUUID := state.Vault.ValueString()
+	groupUUID := state.Group.ValueString()
+
+	accessList, err := r.client.GetVaultPermissions(ctx, vaultUUID)
+	if err != nil {
+		// If the vault no longer exists, remove the permission from state
+		if errors.Is(err, util.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("1Password Vault Permission read error", fmt.Sprintf("Could not get permissions of vault '%s', got error: %s", vaultUUID, err))
+		return
+	}
+
+	groupAccess := findGroupAccess(accessList, groupUUID)
+
+	// If the group no longer has access to the vault, remove it from state
+	// The next Terraform plan will grant the access again
+	if groupAccess == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	names, unknown := model.VaultPermissionsToNames(groupAccess.Permissions)
+	if unknown != 0 {
+		resp.Diagnostics.AddWarning(
+			"Unknown vault permissions",
+			fmt.Sprintf("Group '%s' has permissions on vault '%s' that the provider doesn't know (%#x). They aren't shown in \"permissions\" and are kept when the permissions are updated.", groupUUID, vaultUUID, unknown),
+		)
+	}
+	permissions, diags := types.SetValueFrom(ctx, types.StringType, names)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.ID = types.StringValue(vaultPermissionTerraformID(vaultUUID, groupUUID))
+	state.Permissions = permissions
+
+	// Save updated state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *OnePasswordVaultPermissionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, warn := warnOnRateLimit(ctx, &resp.Diagnostics)
+	defer warn()
+
+	var plan OnePasswordVaultPermissionResourceModel
+
+	// Read Terraform plan into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	access, err := vaultPermissionPlanToModel(ctx, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError("1Password Vault Permission update error", err.Error())
+		return
+	}
+
+	// Keep the permissions the provider doesn't know, which were granted outside of Terraform
+	accessList, err := r.client.GetVaultPermissions(ctx, access.VaultID)
+	if err != nil {
+		resp.Diagnostics.AddError("1Password Vault Permission update error", fmt.Sprintf("Could not get permissions of vault '%s', got error: %s", access.VaultID, err))
+		return
+	}
+	if current := findGroupAccess(accessList, access.AccessorID); current != nil {
+		_, unknown := model.VaultPermissionsToNames(current.Permissions)
+		access.Permissions |= unknown
+	}
+
+	err = r.client.UpdateVaultGroupPermissions(ctx, *access)
+	if err != nil {
+		resp.Diagnostics.AddError("1Password Vault Permission update error", fmt.Sprintf("Could not update permissions of group '%s' on vault '%s', got error: %s", access.AccessorID, access.VaultID, err))
+		return
+	}
+
+	plan.ID = types.StringValue(vaultPermissionTerraformID(access.VaultID, access.AccessorID))
+
+	// Save updated plan into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *OnePasswordVaultPermissionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, warn := warnOnRateLimit(ctx, &resp.Diagnostics)
+	defer warn()
+
+	var state OnePasswordVaultPermissionResourceModel
+
+	// Read Terraform prior state into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.RevokeVaultGroupPermissions(ctx, state.Vault.ValueString(), state.Group.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("1Password Vault Permission delete error", fmt.Sprintf("Could not revoke access of group '%s' to vault '%s', got error: %s", state.Group.ValueString(), state.Vault.ValueString(), err))
+		return
+	}
+}
+
+func (r *OnePasswordVaultPermissionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	vaultUUID, groupUUID, ok := vaultPermissionUUIDsFromTerraformID(req.ID)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"1Password Vault Permission import error",
+			fmt.Sprintf("Invalid import ID '%s', expected format `vaults/<vault_id>/groups/<group_id>`", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vault"), vaultUUID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group"), groupUUID)...)
+}
+
+func vaultPermissionPlanToModel(ctx context.Context, plan *OnePasswordVaultPermissionResourceModel) (*model.VaultAccess, error) {
+	var names []string
+	diags := plan.Permissions.ElementsAs(ctx, &names, false)
+	if diags.HasError() {
+		return nil, fmt.Errorf("could not read permissions: %v", diags)
+	}
+
+	permissions, err := model.VaultPermissionsFromNames(names)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.VaultAccess{
+		VaultID:      plan.Vault.ValueString(),
+		AccessorType: model.VaultAccessorTypeGroup,
+		AccessorID:   plan.Group.ValueString(),
+		Permissions:  permissions,
+	}, nil
+}
+
+// findGroupAccess returns the access of the group in the list, or nil when the group has no access.
+func findGroupAccess(accessList []model.VaultAccess, groupUUID string) *model.VaultAccess {
+	for i := range accessList {
+		if accessList[i].AccessorType == model.VaultAccessorTypeGroup && accessList[i].AccessorID == groupUUID {
+			return &accessList[i]
+		}
+	}
+	return nil
+}
+
+func vaultPermissionTerraformID(vaultUUID, groupUUID string) string {
+	return fmt.Sprintf("vaults/%s/groups/%s", vaultUUID, groupUUID)
+}
+
+func vaultPermissionUUIDsFromTerraformID(tfID string) (string, string, bool) {
+	elements := strings.Split(tfID, "/")
+
+	if len(elements) != 4 || elements[0] != "vaults" || elements[2] != "groups" || elements[1] == "" || elements[3] == "" {
+		return "", "", false
+	}
+
+	return elements[1], elements[3], true
+}
@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/model"
+)
+
+func TestAccVaultPermissionResource_ConnectNotSupported(t *testing.T) {
+	expectedItem := generateBaseItem()
+	expectedVault := model.Vault{
+		ID:   expectedItem.VaultID,
+		Name: "VaultName",
+	}
+
+	testServer := setupTestServer(&expectedItem, expectedVault, t)
+	defer testServer.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProviderConfig(testServer.URL) + testAccVaultPermissionResourceConfig(expectedVault.ID, "group1"),
+				ExpectError: regexp.MustCompile("not available with 1Password Connect"),
+			},
+		},
+	})
+}
+
+func TestVaultPermissionUUIDsFromTerraformID(t *testing.T) {
+	tests := map[string]struct {
+		input         string
+		expectedVault string
+		expectedGroup string
+		expectedOK    bool
+	}{
+		"should parse valid ID": {
+			input:         "vaults/vault1/groups/group1",
+			expectedVault: "vault1",
+			expectedGroup: "group1",
+			expectedOK:    true,
+		},
+		"should reject vault ID": {
+			input: "vaults/vault1",
+		},
+		"should reject wrong accessor type": {
+			input: "vaults/vault1/users/user1",
+		},
+		"should reject empty group": {
+			input: "vaults/vault1/groups/",
+		},
+	}
+
+	for description, test := range tests {
+		t.Run(description, func(t *testing.T) {
+			vaultUUID, groupUUID, ok := vaultPermissionUUIDsFromTerraformID(test.input)
+			if ok != test.expectedOK || vaultUUID != test.expectedVault || groupUUID != test.expectedGroup {
+				t.Errorf("Expected (%q, %q, %t), got (%q, %q, %t)", test.expectedVault, test.expectedGroup, test.expectedOK, vaultUUID, groupUUID, ok)
+			}
+		})
+	}
+}
+
+func testAccVaultPermissionResourceConfig(vault, group string) string {
+	return fmt.Sprintf(`
+resource "onepassword_vault_permission" "test" {
+  vault       = "%s"
+  group       = "%s"
+  permissions = ["read_items", "reveal_item_password"]
+}`, vault, group)
+}
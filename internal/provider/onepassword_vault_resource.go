@@ -0,0 +1,233 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword"
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/model"
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/util"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &OnePasswordVaultResource{}
+var _ resource.ResourceWithImportState = &OnePasswordVaultResource{}
+
+func NewOnePasswordVaultResource() resource.Resource {
+	return &OnePasswordVaultResource{}
+}
+
+// OnePasswordVaultResource defines the resource implementation.
+type OnePasswordVaultResource struct {
+	client onepassword.Client
+}
+
+// OnePasswordVaultResourceModel describes the resource data model.
+type OnePasswordVaultResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	UUID        types.String `tfsdk:"uuid"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+}
+
+func (r *OnePasswordVaultResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vault"
+}
+
+func (r *OnePasswordVaultResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "A 1Password Vault. Managing vaults is only supported when using **service account** or **desktop app** authentication; it is not available with 1Password Connect. The vault icon can't be set, as neither the 1Password SDK nor Connect supports it.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The Terraform resource identifier for this vault in the format `vaults/<vault_id>`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the vault.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the vault.",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the vault.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *OnePasswordVaultResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(onepassword.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected onepassword.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *OnePasswordVaultResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, warn := warnOnRateLimit(ctx, &resp.Diagnostics)
+	defer warn()
+
+	var plan OnePasswordVaultResourceModel
+
+	// Read Terraform plan into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createdVault, err := r.client.CreateVault(ctx, &model.Vault{
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("1Password Vault create error", fmt.Sprintf("Error creating 1Password vault, got error %s", err))
+		return
+	}
+
+	vaultModelToState(createdVault, &plan)
+
+	tflog.Trace(ctx, "created a vault resource")
+
+	// Save plan into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *OnePasswordVaultResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, warn := warnOnRateLimit(ctx, &resp.Diagnostics)
+	defer warn()
+
+	var state OnePasswordVaultResourceModel
+
+	// Read Terraform prior state into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vaultUUID, _ := vaultUUIDFromTerraformID(state.ID.ValueString())
+	vault, err := r.client.GetVault(ctx, vaultUUID)
+	if err != nil {
+		// If the vault no longer exists, remove it from state
+		// The next Terraform plan will recreate the resource
+		if errors.Is(err, util.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("1Password Vault read error", fmt.Sprintf("Could not get vault '%s', got error: %s", vaultUUID, err))
+		return
+	}
+
+	vaultModelToState(vault, &state)
+
+	// Save updated state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *OnePasswordVaultResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, warn := warnOnRateLimit(ctx, &resp.Diagnostics)
+	defer warn()
+
+	var plan OnePasswordVaultResourceModel
+
+	// Read Terraform plan into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updatedVault, err := r.client.UpdateVault(ctx, &model.Vault{
+		ID:          plan.UUID.ValueString(),
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("1Password Vault update error", fmt.Sprintf("Could not update vault '%s', got error: %s", plan.UUID.ValueString(), err))
+		return
+	}
+
+	vaultModelToState(updatedVault, &plan)
+
+	// Save updated plan into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *OnePasswordVaultResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, warn := warnOnRateLimit(ctx, &resp.Diagnostics)
+	defer warn()
+
+	var state OnePasswordVaultResourceModel
+
+	// Read Terraform prior state into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteVault(ctx, state.UUID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("1Password Vault delete error", fmt.Sprintf("Could not delete vault '%s', got error: %s", state.UUID.ValueString(), err))
+		return
+	}
+}
+
+func (r *OnePasswordVaultResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if _, ok := vaultUUIDFromTerraformID(req.ID); !ok {
+		resp.Diagnostics.AddError(
+			"1Password Vault import error",
+			fmt.Sprintf("Invalid import ID '%s', expected format `vaults/<vault_id>`", req.ID),
+		)
+		return
+	}
+
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func vaultModelToState(vault *model.Vault, state *OnePasswordVaultResourceModel) {
+	state.ID = types.StringValue(vaultTerraformID(vault))
+	state.UUID = types.StringValue(vault.ID)
+	state.Name = types.StringValue(vault.Name)
+	state.Description = setStringValuePreservingEmpty(vault.Description, state.Description)
+}
+
+func vaultUUIDFromTerraformID(tfID string) (string, bool) {
+	elements := strings.Split(tfID, "/")
+
+	if len(elements) != 2 || elements[0] != "vaults" || elements[1] == "" {
+		return "", false
+	}
+
+	return elements[1], true
+}
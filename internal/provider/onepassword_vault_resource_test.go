@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/model"
+)
+
+func TestAccVaultResource_ConnectNotSupported(t *testing.T) {
+	expectedItem := generateBaseItem()
+	expectedVault := model.Vault{
+		ID:   expectedItem.VaultID,
+		Name: "VaultName",
+	}
+
+	testServer := setupTestServer(&expectedItem, expectedVault, t)
+	defer testServer.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProviderConfig(testServer.URL) + testAccVaultResourceConfig("Example Vault"),
+				ExpectError: regexp.MustCompile("not available with 1Password Connect"),
+			},
+		},
+	})
+}
+
+func testAccVaultResourceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "onepassword_vault" "test" {
+  name        = "%s"
+  description = "Vault managed by Terraform"
+}`, name)
+}
+
+func TestVaultUUIDFromTerraformID(t *testing.T) {
+	tests := map[string]struct {
+		input         string
+		expectedVault string
+		expectedOK    bool
+	}{
+		"should parse valid ID": {
+			input:         "vaults/vault1",
+			expectedVault: "vault1",
+			expectedOK:    true,
+		},
+		"should reject bare vault UUID": {
+			input: "vault1",
+		},
+		"should reject item ID": {
+			input: "vaults/vault1/items/item1",
+		},
+		"should reject wrong prefix": {
+			input: "vault/vault1",
+		},
+		"should reject empty vault": {
+			input: "vaults/",
+		},
+	}
+
+	for description, test := range tests {
+		t.Run(description, func(t *testing.T) {
+			vaultUUID, ok := vaultUUIDFromTerraformID(test.input)
+			if ok != test.expectedOK || vaultUUID != test.expectedVault {
+				t.Errorf("Expected (%q, %t), got (%q, %t)", test.expectedVault, test.expectedOK, vaultUUID, ok)
+			}
+		})
+	}
+}
@@ -0,0 +1,196 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword"
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/model"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &OnePasswordVaultsDataSource{}
+
+func NewOnePasswordVaultsDataSource() datasource.DataSource {
+	return &OnePasswordVaultsDataSource{}
+}
+
+// OnePasswordVaultsDataSource defines the data source implementation.
+type OnePasswordVaultsDataSource struct {
+	client onepassword.Client
+}
+
+// OnePasswordVaultsDataSourceModel describes the data source data model.
+type OnePasswordVaultsDataSourceModel struct {
+	NamePrefix types.String                  `tfsdk:"name_prefix"`
+	NameRegex  types.String                  `tfsdk:"name_regex"`
+	Vaults     []OnePasswordVaultsVaultModel `tfsdk:"vaults"`
+}
+
+// OnePasswordVaultsVaultModel describes a single vault returned by the data source.
+type OnePasswordVaultsVaultModel struct {
+	ID          types.String `tfsdk:"id"`
+	UUID        types.String `tfsdk:"uuid"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	ItemCount   types.Int64  `tfsdk:"item_count"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+	UpdatedAt   types.String `tfsdk:"updated_at"`
+}
+
+func (d *OnePasswordVaultsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vaults"
+}
+
+func (d *OnePasswordVaultsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Use this data source to list all vaults that are accessible with the configured credentials, optionally filtered by name.",
+
+		Attributes: map[string]schema.Attribute{
+			"name_prefix": schema.StringAttribute{
+				MarkdownDescription: "Only return vaults whose name starts with this prefix.",
+				Optional:            true,
+			},
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Only return vaults whose name matches this regular expression (RE2 syntax).",
+				Optional:            true,
+			},
+			"vaults": schema.ListNestedAttribute{
+				MarkdownDescription: "The vaults matching the filters, sorted by name.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The Terraform resource identifier for this vault in the format `vaults/<vault_id>`.",
+							Computed:            true,
+						},
+						"uuid": schema.StringAttribute{
+							MarkdownDescription: "The UUID of the vault.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the vault.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The description of the vault.",
+							Computed:            true,
+						},
+						"item_count": schema.Int64Attribute{
+							MarkdownDescription: "The number of active items in the vault.",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "The time the vault was created, in RFC 3339 format.",
+							Computed:            true,
+						},
+						"updated_at": schema.StringAttribute{
+							MarkdownDescription: "The time the vault was last updated, in RFC 3339 format.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *OnePasswordVaultsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(onepassword.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected onepassword.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *OnePasswordVaultsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, warn := warnOnRateLimit(ctx, &resp.Diagnostics)
+	defer warn()
+
+	var data OnePasswordVaultsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if data.NameRegex.ValueString() != "" {
+		var err error
+		nameRegex, err = regexp.Compile(data.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid name_regex", fmt.Sprintf("Unable to parse name_regex, got error: %s", err))
+			return
+		}
+	}
+
+	vaults, err := d.client.GetVaults(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list vaults, got error: %s", err))
+		return
+	}
+
+	vaults = filterVaults(vaults, data.NamePrefix.ValueString(), nameRegex)
+
+	data.Vaults = make([]OnePasswordVaultsVaultModel, len(vaults))
+	for i, vault := range vaults {
+		data.Vaults[i] = OnePasswordVaultsVaultModel{
+			ID:          types.StringValue(vaultTerraformID(&vault)),
+			UUID:        types.StringValue(vault.ID),
+			Name:        types.StringValue(vault.Name),
+			Description: types.StringValue(vault.Description),
+			ItemCount:   types.Int64Value(int64(vault.ItemCount)),
+			CreatedAt:   setTimeValue(vault.CreatedAt),
+			UpdatedAt:   setTimeValue(vault.UpdatedAt),
+		}
+	}
+
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// filterVaults returns the vaults whose name matches the given prefix and regular expression, sorted by name.
+// An empty prefix or nil regular expression matches every vault.
+func filterVaults(vaults []model.Vault, namePrefix string, nameRegex *regexp.Regexp) []model.Vault {
+	result := []model.Vault{}
+	for _, vault := range vaults {
+		if !strings.HasPrefix(vault.Name, namePrefix) {
+			continue
+		}
+		if nameRegex != nil && !nameRegex.MatchString(vault.Name) {
+			continue
+		}
+		result = append(result, vault)
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+
+	return result
+}
@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/model"
+)
+
+func TestAccVaultsDataSource(t *testing.T) {
+	expectedItem := generateDatabaseItem()
+	expectedVault := model.Vault{
+		ID:          expectedItem.VaultID,
+		Name:        "Name of the vault",
+		Description: "This vault will be listed",
+		ItemCount:   1,
+		CreatedAt:   time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		UpdatedAt:   time.Date(2024, 6, 7, 8, 9, 10, 0, time.UTC),
+	}
+
+	testServer := setupTestServer(expectedItem, expectedVault, t)
+	defer testServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(testServer.URL) + testAccVaultsDataSourceConfig("Name of"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.onepassword_vaults.test", "vaults.#", "1"),
+					resource.TestCheckResourceAttr("data.onepassword_vaults.test", "vaults.0.id", fmt.Sprintf("vaults/%s", expectedVault.ID)),
+					resource.TestCheckResourceAttr("data.onepassword_vaults.test", "vaults.0.uuid", expectedVault.ID),
+					resource.TestCheckResourceAttr("data.onepassword_vaults.test", "vaults.0.name", expectedVault.Name),
+					resource.TestCheckResourceAttr("data.onepassword_vaults.test", "vaults.0.description", expectedVault.Description),
+					resource.TestCheckResourceAttr("data.onepassword_vaults.test", "vaults.0.item_count", "1"),
+					resource.TestCheckResourceAttr("data.onepassword_vaults.test", "vaults.0.created_at", "2024-01-02T03:04:05Z"),
+					resource.TestCheckResourceAttr("data.onepassword_vaults.test", "vaults.0.updated_at", "2024-06-07T08:09:10Z"),
+				),
+			},
+			{
+				Config: testAccProviderConfig(testServer.URL) + testAccVaultsDataSourceConfig("Other"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.onepassword_vaults.test", "vaults.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestFilterVaults(t *testing.T) {
+	vaults := []model.Vault{
+		{ID: "1", Name: "prod-payments"},
+		{ID: "2", Name: "dev-payments"},
+		{ID: "3", Name: "prod-api"},
+	}
+
+	tests := map[string]struct {
+		namePrefix  string
+		nameRegex   *regexp.Regexp
+		expectedIDs []string
+	}{
+		"should return all vaults sorted by name without filters": {
+			expectedIDs: []string{"2", "3", "1"},
+		},
+		"should filter by prefix": {
+			namePrefix:  "prod-",
+			expectedIDs: []string{"3", "1"},
+		},
+		"should filter by regex": {
+			nameRegex:   regexp.MustCompile("payments$"),
+			expectedIDs: []string{"2", "1"},
+		},
+		"should combine prefix and regex": {
+			namePrefix:  "prod-",
+			nameRegex:   regexp.MustCompile("payments$"),
+			expectedIDs: []string{"1"},
+		},
+		"should return empty list when nothing matches": {
+			namePrefix:  "staging-",
+			expectedIDs: []string{},
+		},
+	}
+
+	for description, test := range tests {
+		t.Run(description, func(t *testing.T) {
+			filtered := filterVaults(vaults, test.namePrefix, test.nameRegex)
+			ids := make([]string, len(filtered))
+			for i, vault := range filtered {
+				ids[i] = vault.ID
+			}
+			if !reflect.DeepEqual(ids, test.expectedIDs) {
+				t.Errorf("Expected %v, got %v", test.expectedIDs, ids)
+			}
+		})
+	}
+}
+
+func testAccVaultsDataSourceConfig(namePrefix string) string {
+	return fmt.Sprintf(`
+data "onepassword_vaults" "test" {
+  name_prefix = "%s"
+}`, namePrefix)
+}
@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/util"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &ParseSecretReferenceFunction{}
+
+var secretReferenceAttributeTypes = map[string]attr.Type{
+	"vault":      types.StringType,
+	"item":       types.StringType,
+	"section":    types.StringType,
+	"field":      types.StringType,
+	"attribute":  types.StringType,
+	"ssh_format": types.StringType,
+}
+
+func NewParseSecretReferenceFunction() function.Function {
+	return &ParseSecretReferenceFunction{}
+}
+
+// ParseSecretReferenceFunction defines the function implementation.
+type ParseSecretReferenceFunction struct{}
+
+func (f *ParseSecretReferenceFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_secret_reference"
+}
+
+func (f *ParseSecretReferenceFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Parse a 1Password secret reference",
+		MarkdownDescription: "Parses a 1Password secret reference in the format `op://<vault>/<item>/[<section>/]<field>` with the optional `attribute` and `ssh-format` query parameters. " +
+			"Returns an object with the `vault`, `item`, `section`, `field`, `attribute` and `ssh_format` components; components that are not present are null. " +
+			"Provider functions can't access the provider configuration, so the reference is not resolved. " +
+			"Use the components with the `onepassword_item` data source or ephemeral resource to read the value.",
+
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "reference",
+				MarkdownDescription: "The secret reference to parse, e.g. `op://Production/Database/password`.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: secretReferenceAttributeTypes,
+		},
+	}
+}
+
+func (f *ParseSecretReferenceFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var reference string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &reference))
+	if resp.Error != nil {
+		return
+	}
+
+	ref, err := util.ParseSecretReference(reference)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	result, diags := types.ObjectValue(secretReferenceAttributeTypes, map[string]attr.Value{
+		"vault":      types.StringValue(ref.Vault),
+		"item":       types.StringValue(ref.Item),
+		"section":    setStringValue(ref.Section),
+		"field":      types.StringValue(ref.Field),
+		"attribute":  setStringValue(ref.Attribute),
+		"ssh_format": setStringValue(ref.SSHFormat),
+	})
+	resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diags))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+)
+
+func TestAccParseSecretReferenceFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "test" {
+  value = provider::onepassword::parse_secret_reference("op://Production/Database/admin/password")
+}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"vault":      knownvalue.StringExact("Production"),
+						"item":       knownvalue.StringExact("Database"),
+						"section":    knownvalue.StringExact("admin"),
+						"field":      knownvalue.StringExact("password"),
+						"attribute":  knownvalue.Null(),
+						"ssh_format": knownvalue.Null(),
+					})),
+				},
+			},
+			{
+				Config: `
+output "test" {
+  value = provider::onepassword::parse_secret_reference("vault/item/field")
+}`,
+				ExpectError: regexp.MustCompile(`must start with "op://"`),
+			},
+		},
+	})
+}
+
+func TestParseSecretReferenceFunctionRun(t *testing.T) {
+	tests := map[string]struct {
+		reference   string
+		expected    map[string]attr.Value
+		expectError bool
+	}{
+		"should return components of reference with query": {
+			reference: "op://vault/ssh key/private key?ssh-format=openssh",
+			expected: map[string]attr.Value{
+				"vault":      types.StringValue("vault"),
+				"item":       types.StringValue("ssh key"),
+				"section":    types.StringNull(),
+				"field":      types.StringValue("private key"),
+				"attribute":  types.StringNull(),
+				"ssh_format": types.StringValue("openssh"),
+			},
+		},
+		"should return error for invalid reference": {
+			reference:   "op://vault/item",
+			expectError: true,
+		},
+	}
+
+	for description, test := range tests {
+		t.Run(description, func(t *testing.T) {
+			ctx := context.Background()
+			req := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(test.reference)}),
+			}
+			resp := &function.RunResponse{
+				Result: function.NewResultData(types.ObjectUnknown(secretReferenceAttributeTypes)),
+			}
+
+			NewParseSecretReferenceFunction().Run(ctx, req, resp)
+
+			if test.expectError {
+				if resp.Error == nil {
+					t.Errorf("Expected error, got nil")
+				}
+				return
+			}
+			if resp.Error != nil {
+				t.Fatalf("Unexpected error: %s", resp.Error)
+			}
+
+			expected := types.ObjectValueMust(secretReferenceAttributeTypes, test.expected)
+			if !resp.Result.Value().Equal(expected) {
+				t.Errorf("Expected %s, got %s", expected, resp.Result.Value())
+			}
+		})
+	}
+}
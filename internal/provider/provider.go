@@ -4,9 +4,15 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strconv"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -17,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword"
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/util"
 )
 
 // Ensure ScaffoldingProvider satisfies various provider interfaces.
@@ -33,15 +40,42 @@ type OnePasswordProvider struct {
 
 // OnePasswordProviderModel describes the provider data model.
 type OnePasswordProviderModel struct {
-	ConnectHost         types.String `tfsdk:"connect_url"`
-	ConnectToken        types.String `tfsdk:"connect_token"`
-	ServiceAccountToken types.String `tfsdk:"service_account_token"`
-	Account             types.String `tfsdk:"account"`
+	ConnectHost         types.String     `tfsdk:"connect_url"`
+	ConnectToken        types.String     `tfsdk:"connect_token"`
+	ServiceAccountToken types.String     `tfsdk:"service_account_token"`
+	Account             types.String     `tfsdk:"account"`
+	FakeBackend         types.Bool       `tfsdk:"fake_backend"`
+	FakeBackendDataFile types.String     `tfsdk:"fake_backend_data_file"`
+	Retry               []RetryModel     `tfsdk:"retry"`
+	RateLimit           []RateLimitModel `tfsdk:"rate_limit"`
 	// Old field names - these are deprecated and will be removed in a future version.
 	ConnectHostOld  types.String `tfsdk:"url"`
 	ConnectTokenOld types.String `tfsdk:"token"`
 }
 
+// RetryModel describes the retry block of the provider.
+type RetryModel struct {
+	MaxAttempts types.Int64  `tfsdk:"max_attempts"`
+	BaseDelay   types.String `tfsdk:"base_delay"`
+	MaxDelay    types.String `tfsdk:"max_delay"`
+	Timeout     types.String `tfsdk:"timeout"`
+	RetryOn     types.Set    `tfsdk:"retry_on"`
+}
+
+// RateLimitModel describes the rate_limit block of the provider.
+type RateLimitModel struct {
+	RequestsPerMinute     types.Int64  `tfsdk:"requests_per_minute"`
+	Burst                 types.Int64  `tfsdk:"burst"`
+	MaxConcurrentRequests types.Int64  `tfsdk:"max_concurrent_requests"`
+	MaxWait               types.String `tfsdk:"max_wait"`
+}
+
+const (
+	retryOnRateLimited   = "rate_limited"
+	retryOnServerErrors  = "server_errors"
+	retryOnNetworkErrors = "network_errors"
+)
+
 func (p *OnePasswordProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
 	resp.TypeName = "onepassword"
 	resp.Version = p.version
@@ -93,6 +127,90 @@ func (p *OnePasswordProvider) Schema(ctx context.Context, req provider.SchemaReq
 				Description: "A valid account name or ID to use desktop app authentication. Can also be sourced from `OP_ACCOUNT` environment variable.",
 				Optional:    true,
 			},
+			"fake_backend": schema.BoolAttribute{
+				MarkdownDescription: "Use an in-memory fake 1Password backend instead of a 1Password account, for example to run `terraform plan` and `terraform apply` in CI. Can also be sourced from `OP_FAKE_BACKEND` environment variable. Nothing is stored in 1Password.",
+				Optional:            true,
+			},
+			"fake_backend_data_file": schema.StringAttribute{
+				MarkdownDescription: "A JSON file the fake backend loads its vaults, items and Environments from and stores every change in. Without it, the data of the fake backend is lost when the provider exits. Can also be sourced from `OP_FAKE_BACKEND_DATA_FILE` environment variable.",
+				Optional:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"retry": schema.ListNestedBlock{
+				MarkdownDescription: "Configures how requests to 1Password are retried. Conflicts are always retried, and with Connect also requests for items that aren't synced yet.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"max_attempts": schema.Int64Attribute{
+							MarkdownDescription: "The number of times a request is tried, including the first attempt. Defaults to `5`.",
+							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+						"base_delay": schema.StringAttribute{
+							MarkdownDescription: "The delay before the first retry, such as `\"200ms\"`. The delay doubles with every further retry, up to `max_delay`. Defaults to `\"100ms\"`.",
+							Optional:            true,
+						},
+						"max_delay": schema.StringAttribute{
+							MarkdownDescription: "The maximum delay between two attempts, such as `\"10s\"`. Defaults to `\"500ms\"`, or `base_delay` when it's longer.",
+							Optional:            true,
+						},
+						"timeout": schema.StringAttribute{
+							MarkdownDescription: "The maximum total time spent retrying a request, such as `\"2m\"`. No retry is started after it. Not limited by default.",
+							Optional:            true,
+						},
+						"retry_on": schema.SetAttribute{
+							MarkdownDescription: fmt.Sprintf("Additional classes of errors to retry: `%s` for rate limited requests (429), `%s` for 5xx responses and `%s` for failed connections. `%s` is ignored when the `rate_limit` block is set, which retries rate limited requests itself.", retryOnRateLimited, retryOnServerErrors, retryOnNetworkErrors, retryOnRateLimited),
+							Optional:            true,
+							ElementType:         types.StringType,
+							Validators: []validator.Set{
+								setvalidator.ValueStringsAre(
+									stringvalidator.OneOf(retryOnRateLimited, retryOnServerErrors, retryOnNetworkErrors),
+								),
+							},
+						},
+					},
+				},
+			},
+			"rate_limit": schema.ListNestedBlock{
+				MarkdownDescription: "Limits the requests made to 1Password, for example to stay below the rate limits of service accounts with many items. Requests rate limited by 1Password are retried and reported as warnings instead of failing.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"requests_per_minute": schema.Int64Attribute{
+							MarkdownDescription: "The maximum number of requests started per minute. Not limited by default.",
+							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+						"burst": schema.Int64Attribute{
+							MarkdownDescription: "The number of requests that can be started at once before `requests_per_minute` applies. Defaults to `1`.",
+							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+						"max_concurrent_requests": schema.Int64Attribute{
+							MarkdownDescription: "The maximum number of requests running at the same time, regardless of Terraform's parallelism. Not limited by default.",
+							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+						"max_wait": schema.StringAttribute{
+							MarkdownDescription: "How long a request rate limited by 1Password is retried before failing, such as `\"10m\"`. Defaults to `\"5m\"`.",
+							Optional:            true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -112,6 +230,8 @@ func (p *OnePasswordProvider) Configure(ctx context.Context, req provider.Config
 	connectToken := os.Getenv("OP_CONNECT_TOKEN")
 	serviceAccountToken := os.Getenv("OP_SERVICE_ACCOUNT_TOKEN")
 	account := os.Getenv("OP_ACCOUNT")
+	fakeBackend, _ := strconv.ParseBool(os.Getenv("OP_FAKE_BACKEND"))
+	fakeBackendDataFile := os.Getenv("OP_FAKE_BACKEND_DATA_FILE")
 
 	// Configuration values are now available.
 	if !config.ConnectHost.IsNull() {
@@ -135,6 +255,12 @@ func (p *OnePasswordProvider) Configure(ctx context.Context, req provider.Config
 	if !config.Account.IsNull() {
 		account = config.Account.ValueString()
 	}
+	if !config.FakeBackend.IsNull() {
+		fakeBackend = config.FakeBackend.ValueBool()
+	}
+	if !config.FakeBackendDataFile.IsNull() {
+		fakeBackendDataFile = config.FakeBackendDataFile.ValueString()
+	}
 
 	// This is not handled by setting Required to true because Terraform does not handle
 	// multiple required attributes well. If only one is set in the provider configuration,
@@ -150,6 +276,15 @@ func (p *OnePasswordProvider) Configure(ctx context.Context, req provider.Config
 		}
 	}
 
+	if fakeBackend && (serviceAccountToken != "" || account != "" || connectToken != "" || connectHost != "") {
+		resp.Diagnostics.AddError("Config conflict", "\"fake_backend\" is set together with 1Password credentials. Please remove the credentials to use the fake backend.")
+	}
+
+	retryPolicy, diags := retryPolicyFromConfig(ctx, config.Retry)
+	resp.Diagnostics.Append(diags...)
+	rateLimit, diags := rateLimitFromConfig(config.RateLimit)
+	resp.Diagnostics.Append(diags...)
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -162,6 +297,10 @@ func (p *OnePasswordProvider) Configure(ctx context.Context, req provider.Config
 		ServiceAccountToken: serviceAccountToken,
 		Account:             account,
 		ProviderUserAgent:   providerUserAgent,
+		Retry:               retryPolicy,
+		RateLimit:           rateLimit,
+		FakeBackend:         fakeBackend,
+		FakeBackendDataFile: fakeBackendDataFile,
 	})
 	if err != nil {
 		resp.Diagnostics.AddError("Client init failure", fmt.Sprintf("Client failed to initialize, got error: %s", err))
@@ -173,28 +312,126 @@ func (p *OnePasswordProvider) Configure(ctx context.Context, req provider.Config
 	resp.EphemeralResourceData = client
 }
 
+// retryPolicyFromConfig converts the retry block to the retry policy of the client.
+func retryPolicyFromConfig(ctx context.Context, config []RetryModel) (util.RetryPolicy, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	policy := util.DefaultRetryPolicy()
+	if len(config) == 0 {
+		return policy, diags
+	}
+
+	retry := config[0]
+	retryPath := path.Root("retry").AtListIndex(0)
+	if !retry.MaxAttempts.IsNull() && !retry.MaxAttempts.IsUnknown() {
+		policy.MaxAttempts = int(retry.MaxAttempts.ValueInt64())
+	}
+
+	durations := map[string]struct {
+		value  types.String
+		target *time.Duration
+	}{
+		"base_delay": {retry.BaseDelay, &policy.BaseDelay},
+		"max_delay":  {retry.MaxDelay, &policy.MaxDelay},
+		"timeout":    {retry.Timeout, &policy.Timeout},
+	}
+	for name, d := range durations {
+		if d.value.IsNull() || d.value.IsUnknown() {
+			continue
+		}
+		duration, err := time.ParseDuration(d.value.ValueString())
+		if err != nil || duration <= 0 {
+			diags.AddAttributeError(retryPath.AtName(name), "Invalid duration", fmt.Sprintf("%q must be a positive duration such as \"500ms\" or \"2m\", got %q.", name, d.value.ValueString()))
+			continue
+		}
+		*d.target = duration
+	}
+	if policy.MaxDelay < policy.BaseDelay && retry.MaxDelay.IsNull() {
+		policy.MaxDelay = policy.BaseDelay
+	} else if policy.MaxDelay < policy.BaseDelay {
+		diags.AddAttributeError(retryPath.AtName("max_delay"), "Invalid duration", "\"max_delay\" must not be shorter than \"base_delay\".")
+	}
+
+	var retryOn []string
+	diags.Append(retry.RetryOn.ElementsAs(ctx, &retryOn, false)...)
+	for _, class := range retryOn {
+		switch class {
+		case retryOnRateLimited:
+			policy.RetryRateLimited = true
+		case retryOnServerErrors:
+			policy.RetryServerErrors = true
+		case retryOnNetworkErrors:
+			policy.RetryNetworkErrors = true
+		}
+	}
+
+	return policy, diags
+}
+
+// rateLimitFromConfig converts the rate_limit block to the rate limit of the client. It returns nil without the block.
+func rateLimitFromConfig(config []RateLimitModel) (*onepassword.RateLimitConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if len(config) == 0 {
+		return nil, diags
+	}
+
+	rateLimit := config[0]
+	result := &onepassword.RateLimitConfig{
+		RequestsPerMinute:     int(rateLimit.RequestsPerMinute.ValueInt64()),
+		Burst:                 int(rateLimit.Burst.ValueInt64()),
+		MaxConcurrentRequests: int(rateLimit.MaxConcurrentRequests.ValueInt64()),
+	}
+	if !rateLimit.MaxWait.IsNull() && !rateLimit.MaxWait.IsUnknown() {
+		maxWait, err := time.ParseDuration(rateLimit.MaxWait.ValueString())
+		if err != nil || maxWait <= 0 {
+			diags.AddAttributeError(path.Root("rate_limit").AtListIndex(0).AtName("max_wait"), "Invalid duration", fmt.Sprintf("\"max_wait\" must be a positive duration such as \"500ms\" or \"2m\", got %q.", rateLimit.MaxWait.ValueString()))
+		}
+		result.MaxWait = maxWait
+	}
+
+	return result, diags
+}
+
+// warnOnRateLimit returns a context that records the requests 1Password rate limited. Calling the returned
+// function adds a warning about them to diags.
+func warnOnRateLimit(ctx context.Context, diags *diag.Diagnostics) (context.Context, func()) {
+	ctx, recorder := onepassword.WithRateLimitRecorder(ctx)
+	return ctx, func() {
+		if hits, waited := recorder.Hits(); hits > 0 {
+			diags.AddWarning("Rate limited by 1Password", fmt.Sprintf("1Password rate limited %d request(s), which were retried after waiting %s in total. Consider lowering \"requests_per_minute\" or \"max_concurrent_requests\" in the \"rate_limit\" block of the provider.", hits, waited))
+		}
+	}
+}
+
 func (p *OnePasswordProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewOnePasswordItemResource,
+		NewOnePasswordItemFieldResource,
+		NewOnePasswordVaultResource,
+		NewOnePasswordVaultPermissionResource,
 	}
 }
 
 func (p *OnePasswordProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
 	return []func() ephemeral.EphemeralResource{
 		NewOnePasswordItemEphemeral,
+		NewOnePasswordEnvironmentEphemeral,
 	}
 }
 
 func (p *OnePasswordProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewOnePasswordItemDataSource,
+		NewOnePasswordItemsDataSource,
 		NewOnePasswordVaultDataSource,
+		NewOnePasswordVaultsDataSource,
 		NewOnePasswordEnvironmentDataSource,
 	}
 }
 
 func (p *OnePasswordProvider) Functions(ctx context.Context) []func() function.Function {
-	return []func() function.Function{}
+	return []func() function.Function{
+		NewParseSecretReferenceFunction,
+	}
 }
 
 func New(version string) func() provider.Provider {
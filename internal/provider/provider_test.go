@@ -1,10 +1,22 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword"
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/util"
 )
 
 // testAccProtoV6ProviderFactories are used to instantiate a provider during
@@ -22,3 +34,198 @@ func testAccProviderConfig(url string) string {
 		connect_token = "<PASSWORD>"
 	  }`, url)
 }
+
+func testAccFakeBackendProviderConfig(dataFile string) string {
+	return fmt.Sprintf(`
+	  provider "onepassword" {
+		fake_backend           = true
+		fake_backend_data_file = "%s"
+	  }`, dataFile)
+}
+
+func TestAccProviderFakeBackend(t *testing.T) {
+	// Every Terraform command starts a new provider, the data file keeps the data in between
+	dataFile := filepath.Join(t.TempDir(), "data.json")
+
+	config := func(token string) string {
+		return testAccFakeBackendProviderConfig(dataFile) + fmt.Sprintf(`
+resource "onepassword_vault" "test" {
+  name = "Fake Vault"
+}
+
+resource "onepassword_item" "test" {
+  vault    = onepassword_vault.test.uuid
+  title    = "Fake Item"
+  category = "login"
+  username = "admin"
+  password_recipe {
+    length = 24
+  }
+}
+
+resource "onepassword_item_field" "test" {
+  vault   = onepassword_vault.test.uuid
+  item    = onepassword_item.test.uuid
+  section = "API"
+  label   = "token"
+  type    = "CONCEALED"
+  value   = "%s"
+}`, token)
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("initial-token"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("onepassword_item.test", "username", "admin"),
+					resource.TestMatchResourceAttr("onepassword_item.test", "password", regexp.MustCompile(`^.{24}$`)),
+					resource.TestCheckResourceAttr("onepassword_item_field.test", "value", "initial-token"),
+				),
+			},
+			{
+				Config: config("rotated-token"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("onepassword_item_field.test", "value", "rotated-token"),
+				),
+			},
+			{
+				ResourceName:      "onepassword_item_field.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestRetryPolicyFromConfig(t *testing.T) {
+	tests := map[string]struct {
+		config         []RetryModel
+		expectedPolicy util.RetryPolicy
+		expectedError  bool
+	}{
+		"should use default policy without retry block": {
+			config:         nil,
+			expectedPolicy: util.DefaultRetryPolicy(),
+		},
+		"should use configured values": {
+			config: []RetryModel{{
+				MaxAttempts: types.Int64Value(10),
+				BaseDelay:   types.StringValue("1s"),
+				MaxDelay:    types.StringValue("30s"),
+				Timeout:     types.StringValue("5m"),
+				RetryOn:     types.SetValueMust(types.StringType, []attr.Value{types.StringValue("rate_limited"), types.StringValue("network_errors")}),
+			}},
+			expectedPolicy: util.RetryPolicy{
+				MaxAttempts:        10,
+				BaseDelay:          time.Second,
+				MaxDelay:           30 * time.Second,
+				Timeout:            5 * time.Minute,
+				RetryRateLimited:   true,
+				RetryNetworkErrors: true,
+			},
+		},
+		"should raise default max delay to base delay": {
+			config: []RetryModel{{
+				MaxAttempts: types.Int64Null(),
+				BaseDelay:   types.StringValue("2s"),
+				MaxDelay:    types.StringNull(),
+				Timeout:     types.StringNull(),
+				RetryOn:     types.SetNull(types.StringType),
+			}},
+			expectedPolicy: util.RetryPolicy{MaxAttempts: 5, BaseDelay: 2 * time.Second, MaxDelay: 2 * time.Second},
+		},
+		"should fail on invalid duration": {
+			config: []RetryModel{{
+				MaxAttempts: types.Int64Null(),
+				BaseDelay:   types.StringValue("soon"),
+				MaxDelay:    types.StringNull(),
+				Timeout:     types.StringNull(),
+				RetryOn:     types.SetNull(types.StringType),
+			}},
+			expectedError: true,
+		},
+		"should fail when max delay is shorter than base delay": {
+			config: []RetryModel{{
+				MaxAttempts: types.Int64Null(),
+				BaseDelay:   types.StringValue("2s"),
+				MaxDelay:    types.StringValue("1s"),
+				Timeout:     types.StringNull(),
+				RetryOn:     types.SetNull(types.StringType),
+			}},
+			expectedError: true,
+		},
+	}
+
+	for description, test := range tests {
+		t.Run(description, func(t *testing.T) {
+			policy, diags := retryPolicyFromConfig(context.Background(), test.config)
+
+			if diags.HasError() != test.expectedError {
+				t.Fatalf("Expected error %v, got %v", test.expectedError, diags)
+			}
+			if !test.expectedError && !reflect.DeepEqual(policy, test.expectedPolicy) {
+				t.Errorf("Expected policy %+v, got %+v", test.expectedPolicy, policy)
+			}
+		})
+	}
+}
+
+func TestRateLimitFromConfig(t *testing.T) {
+	tests := map[string]struct {
+		config            []RateLimitModel
+		expectedRateLimit *onepassword.RateLimitConfig
+		expectedError     bool
+	}{
+		"should not limit requests without rate_limit block": {
+			config:            nil,
+			expectedRateLimit: nil,
+		},
+		"should use configured values": {
+			config: []RateLimitModel{{
+				RequestsPerMinute:     types.Int64Value(300),
+				Burst:                 types.Int64Value(10),
+				MaxConcurrentRequests: types.Int64Value(4),
+				MaxWait:               types.StringValue("10m"),
+			}},
+			expectedRateLimit: &onepassword.RateLimitConfig{
+				RequestsPerMinute:     300,
+				Burst:                 10,
+				MaxConcurrentRequests: 4,
+				MaxWait:               10 * time.Minute,
+			},
+		},
+		"should leave unset limits empty": {
+			config: []RateLimitModel{{
+				RequestsPerMinute:     types.Int64Null(),
+				Burst:                 types.Int64Null(),
+				MaxConcurrentRequests: types.Int64Value(2),
+				MaxWait:               types.StringNull(),
+			}},
+			expectedRateLimit: &onepassword.RateLimitConfig{MaxConcurrentRequests: 2},
+		},
+		"should fail on invalid max_wait": {
+			config: []RateLimitModel{{
+				RequestsPerMinute:     types.Int64Null(),
+				Burst:                 types.Int64Null(),
+				MaxConcurrentRequests: types.Int64Null(),
+				MaxWait:               types.StringValue("-1m"),
+			}},
+			expectedError: true,
+		},
+	}
+
+	for description, test := range tests {
+		t.Run(description, func(t *testing.T) {
+			rateLimit, diags := rateLimitFromConfig(test.config)
+
+			if diags.HasError() != test.expectedError {
+				t.Fatalf("Expected error %v, got %v", test.expectedError, diags)
+			}
+			if !test.expectedError && !reflect.DeepEqual(rateLimit, test.expectedRateLimit) {
+				t.Errorf("Expected rate limit %+v, got %+v", test.expectedRateLimit, rateLimit)
+			}
+		})
+	}
+}
@@ -0,0 +1,286 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword"
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/model"
+)
+
+// sectionFile is a file configured in a section of the item, with its content read from the configuration.
+type sectionFile struct {
+	sectionLabel string
+	name         string
+	content      []byte
+}
+
+type sectionFileKey struct {
+	sectionLabel string
+	name         string
+}
+
+// resolveSectionFiles reads the content of the files configured in the sections and sets their size and hash in the plan.
+// The content is read from the configuration because write-only values are not part of the plan.
+func resolveSectionFiles(plan *OnePasswordItemResourceModel, config OnePasswordItemResourceModel) ([]sectionFile, diag.Diagnostics) {
+	var files []sectionFile
+
+	for i := range plan.SectionList {
+		if i >= len(config.SectionList) {
+			break
+		}
+		section := &plan.SectionList[i]
+		configSection := config.SectionList[i]
+
+		for j := range section.FileList {
+			if j >= len(configSection.FileList) {
+				break
+			}
+			file := &section.FileList[j]
+			configFile := configSection.FileList[j]
+
+			content, err := documentContent(configFile.ContentWO, configFile.ContentBase64WO, configFile.Source)
+			if err != nil {
+				return nil, sectionFileContentError(section.Label.ValueString(), file.Name.ValueString(), err)
+			}
+
+			file.Size = types.Int64Value(int64(len(content)))
+			file.ContentHash = types.StringValue(contentHash(content))
+			files = append(files, sectionFile{
+				sectionLabel: section.Label.ValueString(),
+				name:         file.Name.ValueString(),
+				content:      content,
+			})
+		}
+	}
+
+	for sectionLabel, section := range plan.SectionMap {
+		configSection := config.SectionMap[sectionLabel]
+
+		for name, file := range section.FileMap {
+			configFile := configSection.FileMap[name]
+
+			content, err := documentContent(configFile.ContentWO, configFile.ContentBase64WO, configFile.Source)
+			if err != nil {
+				return nil, sectionFileContentError(sectionLabel, name, err)
+			}
+
+			file.Size = types.Int64Value(int64(len(content)))
+			file.ContentHash = types.StringValue(contentHash(content))
+			section.FileMap[name] = file
+			files = append(files, sectionFile{
+				sectionLabel: sectionLabel,
+				name:         name,
+				content:      content,
+			})
+		}
+	}
+
+	return files, nil
+}
+
+func sectionFileContentError(sectionLabel, name string, err error) diag.Diagnostics {
+	return diag.Diagnostics{diag.NewErrorDiagnostic(
+		"Error reading file content",
+		fmt.Sprintf("Failed to read the content of file '%s' in section '%s', got error: %s", name, sectionLabel, err),
+	)}
+}
+
+// trackedSectionFiles returns the content hashes of the files tracked in state, keyed by section label and file name.
+func trackedSectionFiles(state OnePasswordItemResourceModel) map[sectionFileKey]string {
+	tracked := make(map[sectionFileKey]string)
+
+	for _, section := range state.SectionList {
+		for _, file := range section.FileList {
+			tracked[sectionFileKey{section.Label.ValueString(), file.Name.ValueString()}] = file.ContentHash.ValueString()
+		}
+	}
+	for sectionLabel, section := range state.SectionMap {
+		for name, file := range section.FileMap {
+			tracked[sectionFileKey{sectionLabel, name}] = file.ContentHash.ValueString()
+		}
+	}
+
+	return tracked
+}
+
+// staleSectionFiles returns the attached files that are tracked but no longer configured and the configured files whose content changed.
+// Files that are neither configured nor tracked were not added by Terraform and are left untouched.
+func staleSectionFiles(item *model.Item, files []sectionFile, tracked map[sectionFileKey]string) []model.ItemFile {
+	configured := make(map[sectionFileKey]sectionFile, len(files))
+	for _, f := range files {
+		configured[sectionFileKey{f.sectionLabel, f.name}] = f
+	}
+
+	var stale []model.ItemFile
+	for _, f := range item.Files {
+		if f.SectionID == "" {
+			continue
+		}
+
+		key := sectionFileKey{f.SectionLabel, f.Name}
+		configuredFile, isConfigured := configured[key]
+		trackedHash, isTracked := tracked[key]
+		if !isConfigured && !isTracked {
+			continue
+		}
+		if isConfigured && isTracked && trackedHash == contentHash(configuredFile.content) {
+			continue
+		}
+		stale = append(stale, f)
+	}
+
+	return stale
+}
+
+// removeSectionFiles deletes the stale files that are still attached to the item.
+// It is called once the item is updated and the new files are uploaded, so a failed update doesn't lose the files.
+func removeSectionFiles(ctx context.Context, client onepassword.Client, item *model.Item, stale []model.ItemFile) (*model.Item, error) {
+	for _, s := range stale {
+		i := slices.IndexFunc(item.Files, func(f model.ItemFile) bool { return f.ID == s.ID })
+		if i < 0 {
+			continue
+		}
+
+		f := item.Files[i]
+		var err error
+		item, err = client.DeleteFile(ctx, item, &f, item.VaultID)
+		if err != nil {
+			return nil, fmt.Errorf("could not remove file '%s' from section '%s': %w", f.Name, f.SectionLabel, err)
+		}
+	}
+
+	return item, nil
+}
+
+// uploadSectionFiles uploads the configured files that are not attached to the item or whose attached file is stale.
+func uploadSectionFiles(ctx context.Context, client onepassword.Client, item *model.Item, files []sectionFile, stale []model.ItemFile) (*model.Item, error) {
+	for _, f := range files {
+		if attached := findSectionFileByLabel(item.Files, f.sectionLabel, f.name); attached != nil && !containsFile(stale, attached.ID) {
+			continue
+		}
+
+		sectionID := ""
+		for _, s := range item.Sections {
+			if s.Label == f.sectionLabel {
+				sectionID = s.ID
+				break
+			}
+		}
+		if sectionID == "" {
+			return nil, fmt.Errorf("could not upload file '%s': section '%s' not found", f.name, f.sectionLabel)
+		}
+
+		fieldID, err := uuid.GenerateUUID()
+		if err != nil {
+			return nil, fmt.Errorf("unable to generate a field ID for file '%s', has error: %w", f.name, err)
+		}
+
+		file := &model.ItemFile{
+			Name:         f.name,
+			Size:         len(f.content),
+			SectionID:    sectionID,
+			SectionLabel: f.sectionLabel,
+			FieldID:      fieldID,
+		}
+		file.SetContent(f.content)
+
+		item, err = client.UploadFile(ctx, item, file, item.VaultID)
+		if err != nil {
+			return nil, fmt.Errorf("could not upload file '%s' to section '%s': %w", f.name, f.sectionLabel, err)
+		}
+	}
+
+	return item, nil
+}
+
+// keepStaleFileSections adds the sections of the stale files that the update removes back to the item,
+// so the files can still be removed after the update. It returns the IDs of the added sections.
+func keepStaleFileSections(item *model.Item, stale []model.ItemFile) []string {
+	var kept []string
+	for _, f := range stale {
+		if slices.Contains(kept, f.SectionID) || slices.ContainsFunc(item.Sections, func(s model.ItemSection) bool { return s.ID == f.SectionID }) {
+			continue
+		}
+		item.Sections = append(item.Sections, model.ItemSection{ID: f.SectionID, Label: f.SectionLabel})
+		kept = append(kept, f.SectionID)
+	}
+	return kept
+}
+
+func containsFile(files []model.ItemFile, id string) bool {
+	return slices.ContainsFunc(files, func(f model.ItemFile) bool { return f.ID == id })
+}
+
+func findSectionFileByLabel(files []model.ItemFile, sectionLabel, name string) *model.ItemFile {
+	for i := range files {
+		if files[i].SectionID != "" && files[i].SectionLabel == sectionLabel && files[i].Name == name {
+			return &files[i]
+		}
+	}
+	return nil
+}
+
+func findSectionFile(files []model.ItemFile, sectionID, name string) *model.ItemFile {
+	for i := range files {
+		if files[i].SectionID != "" && files[i].SectionID == sectionID && files[i].Name == name {
+			return &files[i]
+		}
+	}
+	return nil
+}
+
+// toStateSectionFiles updates the files tracked in state with the files attached to the section.
+// Files that are not tracked are ignored and tracked files that no longer exist are removed, so they are uploaded again.
+func toStateSectionFiles(modelFiles []model.ItemFile, sectionID string, stateFiles []OnePasswordItemResourceFileModel) []OnePasswordItemResourceFileModel {
+	if stateFiles == nil {
+		return nil
+	}
+
+	files := make([]OnePasswordItemResourceFileModel, 0, len(stateFiles))
+	for _, stateFile := range stateFiles {
+		modelFile := findSectionFile(modelFiles, sectionID, stateFile.Name.ValueString())
+		if modelFile == nil {
+			continue
+		}
+		stateFile.ContentHash = toStateSectionFileHash(modelFile, stateFile.ID, stateFile.ContentHash)
+		stateFile.ID = types.StringValue(modelFile.ID)
+		files = append(files, stateFile)
+	}
+
+	return files
+}
+
+// toStateSectionFileMap is the map-based equivalent of toStateSectionFiles.
+func toStateSectionFileMap(modelFiles []model.ItemFile, sectionID string, stateFiles map[string]OnePasswordItemResourceFileMapModel) map[string]OnePasswordItemResourceFileMapModel {
+	if stateFiles == nil {
+		return nil
+	}
+
+	files := make(map[string]OnePasswordItemResourceFileMapModel, len(stateFiles))
+	for name, stateFile := range stateFiles {
+		modelFile := findSectionFile(modelFiles, sectionID, name)
+		if modelFile == nil {
+			continue
+		}
+		stateFile.ContentHash = toStateSectionFileHash(modelFile, stateFile.ID, stateFile.ContentHash)
+		stateFile.ID = types.StringValue(modelFile.ID)
+		files[name] = stateFile
+	}
+
+	return files
+}
+
+// toStateSectionFileHash clears the hash of a file that was replaced outside of Terraform,
+// so that the configured content is uploaded again.
+func toStateSectionFileHash(modelFile *model.ItemFile, stateID, stateHash types.String) types.String {
+	if !stateID.IsNull() && !stateID.IsUnknown() && stateID.ValueString() != modelFile.ID {
+		return types.StringNull()
+	}
+	return stateHash
+}
@@ -0,0 +1,247 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword"
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/model"
+)
+
+// fileClient records the files uploaded to and deleted from an item.
+type fileClient struct {
+	onepassword.Client
+	uploaded []string
+	deleted  []string
+}
+
+func (c *fileClient) UploadFile(_ context.Context, item *model.Item, file *model.ItemFile, _ string) (*model.Item, error) {
+	c.uploaded = append(c.uploaded, file.SectionLabel+"/"+file.Name)
+	uploadedFile := *file
+	uploadedFile.ID = "uploaded-" + file.Name
+	item.Files = append(item.Files, uploadedFile)
+	return item, nil
+}
+
+func (c *fileClient) DeleteFile(_ context.Context, item *model.Item, file *model.ItemFile, _ string) (*model.Item, error) {
+	c.deleted = append(c.deleted, file.SectionLabel+"/"+file.Name)
+	return item, nil
+}
+
+func TestResolveSectionFiles(t *testing.T) {
+	sourcePath := filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(sourcePath, []byte("certificate"), 0o600); err != nil {
+		t.Fatalf("Failed to write source file: %v", err)
+	}
+
+	plan := OnePasswordItemResourceModel{
+		SectionList: []OnePasswordItemResourceSectionListModel{
+			{
+				Label: types.StringValue("tls"),
+				FileList: []OnePasswordItemResourceFileModel{
+					{Name: types.StringValue("ca.pem"), ContentHash: types.StringUnknown(), Size: types.Int64Unknown()},
+					{Name: types.StringValue("key.bin"), ContentHash: types.StringUnknown(), Size: types.Int64Unknown()},
+				},
+			},
+		},
+	}
+	config := OnePasswordItemResourceModel{
+		SectionList: []OnePasswordItemResourceSectionListModel{
+			{
+				Label: types.StringValue("tls"),
+				FileList: []OnePasswordItemResourceFileModel{
+					{Name: types.StringValue("ca.pem"), Source: types.StringValue(sourcePath)},
+					{Name: types.StringValue("key.bin"), ContentBase64WO: types.StringValue("3q2+7w==")},
+				},
+			},
+		},
+	}
+
+	files, diags := resolveSectionFiles(&plan, config)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	want := []sectionFile{
+		{sectionLabel: "tls", name: "ca.pem", content: []byte("certificate")},
+		{sectionLabel: "tls", name: "key.bin", content: []byte{0xDE, 0xAD, 0xBE, 0xEF}},
+	}
+	if !reflect.DeepEqual(files, want) {
+		t.Errorf("Files: got %+v, want %+v", files, want)
+	}
+
+	caFile := plan.SectionList[0].FileList[0]
+	if caFile.Size.ValueInt64() != 11 {
+		t.Errorf("Size: got %d, want 11", caFile.Size.ValueInt64())
+	}
+	if caFile.ContentHash.ValueString() != contentHash([]byte("certificate")) {
+		t.Errorf("ContentHash: got %s, want %s", caFile.ContentHash.ValueString(), contentHash([]byte("certificate")))
+	}
+}
+
+func TestResolveSectionFilesMap(t *testing.T) {
+	plan := OnePasswordItemResourceModel{
+		SectionMap: map[string]OnePasswordItemResourceSectionMapModel{
+			"kube": {
+				FileMap: map[string]OnePasswordItemResourceFileMapModel{
+					"config": {ContentHash: types.StringUnknown(), Size: types.Int64Unknown()},
+				},
+			},
+		},
+	}
+	config := OnePasswordItemResourceModel{
+		SectionMap: map[string]OnePasswordItemResourceSectionMapModel{
+			"kube": {
+				FileMap: map[string]OnePasswordItemResourceFileMapModel{
+					"config": {ContentWO: types.StringValue("apiVersion: v1")},
+				},
+			},
+		},
+	}
+
+	files, diags := resolveSectionFiles(&plan, config)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	want := []sectionFile{{sectionLabel: "kube", name: "config", content: []byte("apiVersion: v1")}}
+	if !reflect.DeepEqual(files, want) {
+		t.Errorf("Files: got %+v, want %+v", files, want)
+	}
+	if got := plan.SectionMap["kube"].FileMap["config"].Size.ValueInt64(); got != 14 {
+		t.Errorf("Size: got %d, want 14", got)
+	}
+}
+
+func TestRemoveAndUploadSectionFiles(t *testing.T) {
+	item := &model.Item{
+		Sections: []model.ItemSection{{ID: "s1", Label: "tls"}},
+		Files: []model.ItemFile{
+			{ID: "f1", Name: "unchanged.pem", SectionID: "s1", SectionLabel: "tls"},
+			{ID: "f2", Name: "changed.pem", SectionID: "s1", SectionLabel: "tls"},
+			{ID: "f3", Name: "removed.pem", SectionID: "s1", SectionLabel: "tls"},
+			{ID: "f4", Name: "unmanaged.pem", SectionID: "s1", SectionLabel: "tls"},
+		},
+	}
+	files := []sectionFile{
+		{sectionLabel: "tls", name: "unchanged.pem", content: []byte("same")},
+		{sectionLabel: "tls", name: "changed.pem", content: []byte("new")},
+		{sectionLabel: "tls", name: "added.pem", content: []byte("added")},
+	}
+	tracked := map[sectionFileKey]string{
+		{"tls", "unchanged.pem"}: contentHash([]byte("same")),
+		{"tls", "changed.pem"}:   contentHash([]byte("old")),
+		{"tls", "removed.pem"}:   contentHash([]byte("removed")),
+	}
+
+	stale := staleSectionFiles(item, files, tracked)
+	wantStale := []model.ItemFile{item.Files[1], item.Files[2]}
+	if !reflect.DeepEqual(stale, wantStale) {
+		t.Errorf("Stale: got %v, want %v", stale, wantStale)
+	}
+
+	// The changed file is uploaded while its previous version is still attached
+	client := &fileClient{}
+	updatedItem, err := uploadSectionFiles(context.Background(), client, item, files, stale)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	wantUploaded := []string{"tls/changed.pem", "tls/added.pem"}
+	if !reflect.DeepEqual(client.uploaded, wantUploaded) {
+		t.Errorf("Uploaded: got %v, want %v", client.uploaded, wantUploaded)
+	}
+	if len(client.deleted) > 0 {
+		t.Errorf("Expected no files to be deleted before the upload, got %v", client.deleted)
+	}
+
+	if _, err := removeSectionFiles(context.Background(), client, updatedItem, stale); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	sort.Strings(client.deleted)
+	wantDeleted := []string{"tls/changed.pem", "tls/removed.pem"}
+	if !reflect.DeepEqual(client.deleted, wantDeleted) {
+		t.Errorf("Deleted: got %v, want %v", client.deleted, wantDeleted)
+	}
+}
+
+func TestKeepStaleFileSections(t *testing.T) {
+	item := &model.Item{Sections: []model.ItemSection{{ID: "s1", Label: "tls"}}}
+	stale := []model.ItemFile{
+		{ID: "f1", Name: "ca.pem", SectionID: "s1", SectionLabel: "tls"},
+		{ID: "f2", Name: "old.pem", SectionID: "s2", SectionLabel: "removed"},
+		{ID: "f3", Name: "older.pem", SectionID: "s2", SectionLabel: "removed"},
+	}
+
+	kept := keepStaleFileSections(item, stale)
+
+	if !reflect.DeepEqual(kept, []string{"s2"}) {
+		t.Errorf("Kept: got %v, want [s2]", kept)
+	}
+	wantSections := []model.ItemSection{{ID: "s1", Label: "tls"}, {ID: "s2", Label: "removed"}}
+	if !reflect.DeepEqual(item.Sections, wantSections) {
+		t.Errorf("Sections: got %v, want %v", item.Sections, wantSections)
+	}
+}
+
+func TestUploadSectionFilesMissingSection(t *testing.T) {
+	item := &model.Item{}
+	files := []sectionFile{{sectionLabel: "missing", name: "ca.pem", content: []byte("certificate")}}
+
+	if _, err := uploadSectionFiles(context.Background(), &fileClient{}, item, files, nil); err == nil {
+		t.Error("Expected error for missing section")
+	}
+}
+
+func TestToStateSectionFiles(t *testing.T) {
+	modelFiles := []model.ItemFile{
+		{ID: "f1", Name: "ca.pem", SectionID: "s1"},
+		{ID: "f2-replaced", Name: "key.pem", SectionID: "s1"},
+		{ID: "f3", Name: "unmanaged.pem", SectionID: "s1"},
+	}
+	stateFiles := []OnePasswordItemResourceFileModel{
+		{ID: types.StringUnknown(), Name: types.StringValue("ca.pem"), ContentHash: types.StringValue("hash1")},
+		{ID: types.StringValue("f2"), Name: types.StringValue("key.pem"), ContentHash: types.StringValue("hash2")},
+		{ID: types.StringValue("f4"), Name: types.StringValue("deleted.pem"), ContentHash: types.StringValue("hash4")},
+	}
+
+	got := toStateSectionFiles(modelFiles, "s1", stateFiles)
+
+	want := []OnePasswordItemResourceFileModel{
+		{ID: types.StringValue("f1"), Name: types.StringValue("ca.pem"), ContentHash: types.StringValue("hash1")},
+		{ID: types.StringValue("f2-replaced"), Name: types.StringValue("key.pem"), ContentHash: types.StringNull()},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("toStateSectionFiles() = %+v, want %+v", got, want)
+	}
+
+	if toStateSectionFiles(modelFiles, "s1", nil) != nil {
+		t.Error("Expected nil when no files are tracked")
+	}
+}
+
+func TestToStateSectionFileMap(t *testing.T) {
+	modelFiles := []model.ItemFile{
+		{ID: "f1", Name: "config", SectionID: "s1"},
+	}
+	stateFiles := map[string]OnePasswordItemResourceFileMapModel{
+		"config":  {ID: types.StringValue("f1"), ContentHash: types.StringValue("hash1")},
+		"deleted": {ID: types.StringValue("f2"), ContentHash: types.StringValue("hash2")},
+	}
+
+	got := toStateSectionFileMap(modelFiles, "s1", stateFiles)
+
+	want := map[string]OnePasswordItemResourceFileMapModel{
+		"config": {ID: types.StringValue("f1"), ContentHash: types.StringValue("hash1")},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("toStateSectionFileMap() = %+v, want %+v", got, want)
+	}
+}
@@ -2,13 +2,18 @@ package provider
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
+	"os"
+	"strings"
 
 	op "github.com/1Password/connect-sdk-go/onepassword"
 	"github.com/hashicorp/go-uuid"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/model"
+	opssh "github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/ssh"
 )
 
 func toModelLoginFields(state OnePasswordItemResourceModel, password string, recipe *model.GeneratorRecipe) []model.ItemField {
@@ -110,6 +115,102 @@ func toModelDatabaseFields(state OnePasswordItemResourceModel, password string,
 	}
 }
 
+func toModelAPICredentialFields(state OnePasswordItemResourceModel) []model.ItemField {
+	return []model.ItemField{
+		{
+			ID:    "username",
+			Label: "username",
+			Type:  model.FieldTypeString,
+			Value: state.Username.ValueString(),
+		},
+		{
+			ID:    "credential",
+			Label: "credential",
+			Type:  model.FieldTypeConcealed,
+			Value: state.Credential.ValueString(),
+		},
+		{
+			ID:    "type",
+			Label: "type",
+			Type:  model.FieldTypeMenu,
+			Value: state.Type.ValueString(),
+		},
+		{
+			ID:    "filename",
+			Label: "filename",
+			Type:  model.FieldTypeString,
+			Value: state.Filename.ValueString(),
+		},
+		{
+			ID:    "validFrom",
+			Label: "valid from",
+			Type:  model.FieldTypeDate,
+			Value: state.ValidFrom.ValueString(),
+		},
+		{
+			ID:    "expires",
+			Label: "expires",
+			Type:  model.FieldTypeDate,
+			Value: state.Expires.ValueString(),
+		},
+		{
+			ID:    "hostname",
+			Label: "hostname",
+			Type:  model.FieldTypeString,
+			Value: state.Hostname.ValueString(),
+		},
+		{
+			ID:      "notesPlain",
+			Label:   "notesPlain",
+			Type:    model.FieldTypeString,
+			Purpose: model.FieldPurposeNotes,
+			Value:   state.NoteValue.ValueString(),
+		},
+	}
+}
+
+// toModelDocumentFile returns the document file of a document item with its content loaded from
+// content, content_base64 or the file at source.
+func toModelDocumentFile(state OnePasswordItemResourceModel) (*model.ItemFile, diag.Diagnostics) {
+	content, err := documentContent(state.Content, state.ContentBase64, state.Source)
+	if err != nil {
+		return nil, diag.Diagnostics{diag.NewErrorDiagnostic(
+			"Error reading document content",
+			fmt.Sprintf("Failed to read document content, got error: %s", err),
+		)}
+	}
+
+	file := &model.ItemFile{
+		Name: state.Filename.ValueString(),
+		Size: len(content),
+	}
+	file.SetContent(content)
+
+	return file, nil
+}
+
+// documentContent returns the document content set through content, content_base64 or source.
+// It returns nil if none of them is set.
+func documentContent(content, contentBase64, source types.String) ([]byte, error) {
+	switch {
+	case !content.IsNull():
+		return []byte(content.ValueString()), nil
+	case !contentBase64.IsNull():
+		decoded, err := base64.StdEncoding.DecodeString(contentBase64.ValueString())
+		if err != nil {
+			return nil, fmt.Errorf("content_base64 is not valid base64: %w", err)
+		}
+		return decoded, nil
+	case !source.IsNull():
+		fileContent, err := os.ReadFile(source.ValueString())
+		if err != nil {
+			return nil, fmt.Errorf("could not read source file: %w", err)
+		}
+		return fileContent, nil
+	}
+	return nil, nil
+}
+
 func toModelSecureNoteFields(state OnePasswordItemResourceModel) []model.ItemField {
 	return []model.ItemField{
 		{
@@ -122,6 +223,35 @@ func toModelSecureNoteFields(state OnePasswordItemResourceModel) []model.ItemFie
 	}
 }
 
+// toModelCategoryFields returns the notes and the configured built-in fields of categories without dedicated attributes,
+// in the order the category defines them.
+func toModelCategoryFields(ctx context.Context, state OnePasswordItemResourceModel, category model.ItemCategory) ([]model.ItemField, diag.Diagnostics) {
+	values := map[string]string{}
+	if !state.CategoryFields.IsNull() && !state.CategoryFields.IsUnknown() {
+		diagnostics := state.CategoryFields.ElementsAs(ctx, &values, false)
+		if diagnostics.HasError() {
+			return nil, diagnostics
+		}
+	}
+
+	builtInFields, _ := model.CategoryFields(category)
+	fields := make([]model.ItemField, 0, len(values)+1)
+	for _, f := range builtInFields {
+		value, ok := values[f.ID]
+		if !ok {
+			continue
+		}
+		fields = append(fields, model.ItemField{
+			ID:    f.ID,
+			Label: f.Label,
+			Type:  f.Type,
+			Value: value,
+		})
+	}
+
+	return append(fields, toModelSecureNoteFields(state)...), nil
+}
+
 func toModelSectionField(field OnePasswordItemResourceFieldModel, sectionID, sectionLabel string) (*model.ItemField, diag.Diagnostics) {
 	fieldID := field.ID.ValueString()
 	// Generate field ID if empty
@@ -191,6 +321,28 @@ func toModelSections(state OnePasswordItemResourceModel, modelItem *model.Item)
 	return nil
 }
 
+// toModelSSHKeyFields returns the private key field of an SSH key item.
+// The key is stored in PKCS#8 format; it is omitted when it's not known, e.g. when it was imported as a write-only value.
+func toModelSSHKeyFields(state OnePasswordItemResourceModel) ([]model.ItemField, error) {
+	if state.PrivateKeyOpenSSH.ValueString() == "" {
+		return nil, nil
+	}
+
+	privateKey, err := opssh.NormalizePrivateKey([]byte(state.PrivateKeyOpenSSH.ValueString()))
+	if err != nil {
+		return nil, err
+	}
+
+	return []model.ItemField{
+		{
+			ID:    "private_key",
+			Label: "private key",
+			Type:  model.FieldTypeSSHKey,
+			Value: privateKey,
+		},
+	}, nil
+}
+
 func toModelTags(ctx context.Context, state OnePasswordItemResourceModel) ([]string, diag.Diagnostics) {
 	var tags []string
 	diagnostics := state.Tags.ElementsAs(ctx, &tags, false)
@@ -206,19 +358,41 @@ func parseGeneratorRecipeFromModel(recipe *PasswordRecipeModel) (*model.Generato
 	}
 
 	parsed := &model.GeneratorRecipe{
+		Type:          model.RecipeTypeRandom,
 		Length:        32,
 		CharacterSets: []model.CharacterSet{},
+		WordCount:     4,
+		Separator:     model.SeparatorHyphens,
+		WordList:      model.WordListFullWords,
+	}
+
+	if recipe.Type.ValueString() != "" {
+		parsed.Type = model.GeneratorRecipeType(strings.ToUpper(recipe.Type.ValueString()))
 	}
 
 	length := recipe.Length.ValueInt64()
 	if length > 64 {
 		return nil, fmt.Errorf("password_recipe.length must be an integer between 1 and 64")
 	}
+	if parsed.Type == model.RecipeTypePin && (length < 3 || length > 12) {
+		return nil, fmt.Errorf("password_recipe.length must be an integer between 3 and 12 for PIN recipes")
+	}
 
 	if length > 0 {
 		parsed.Length = int(length)
 	}
 
+	if wordCount := recipe.WordCount.ValueInt64(); wordCount > 0 {
+		parsed.WordCount = int(wordCount)
+	}
+	if recipe.Separator.ValueString() != "" {
+		parsed.Separator = model.PasswordSeparator(strings.ToUpper(recipe.Separator.ValueString()))
+	}
+	if recipe.WordList.ValueString() != "" {
+		parsed.WordList = model.PasswordWordList(strings.ToUpper(recipe.WordList.ValueString()))
+	}
+	parsed.Capitalize = recipe.Capitalize.ValueBool()
+
 	if recipe.Digits.ValueBool() {
 		parsed.CharacterSets = append(parsed.CharacterSets, model.CharacterSetDigits)
 	}
@@ -226,6 +400,30 @@ func parseGeneratorRecipeFromModel(recipe *PasswordRecipeModel) (*model.Generato
 		parsed.CharacterSets = append(parsed.CharacterSets, model.CharacterSetSymbols)
 	}
 
+	// Letters are enabled unless explicitly disabled.
+	parsed.ExcludeLetters = !recipe.Letters.IsNull() && !recipe.Letters.IsUnknown() && !recipe.Letters.ValueBool()
+	parsed.ExcludeCharacters = recipe.ExcludeCharacters.ValueString()
+	parsed.MinDigits = int(recipe.MinDigits.ValueInt64())
+	parsed.MinSymbols = int(recipe.MinSymbols.ValueInt64())
+
+	if parsed.Type == model.RecipeTypeRandom {
+		if parsed.ExcludeLetters && len(parsed.CharacterSets) == 0 {
+			return nil, fmt.Errorf("password_recipe must allow at least one of letters, digits or symbols")
+		}
+		if parsed.MinDigits > 0 && !recipe.Digits.ValueBool() {
+			return nil, fmt.Errorf("password_recipe.min_digits requires password_recipe.digits to be enabled")
+		}
+		if parsed.MinSymbols > 0 && !recipe.Symbols.ValueBool() {
+			return nil, fmt.Errorf("password_recipe.min_symbols requires password_recipe.symbols to be enabled")
+		}
+		if parsed.MinDigits+parsed.MinSymbols > parsed.Length {
+			return nil, fmt.Errorf("password_recipe.min_digits and password_recipe.min_symbols can't exceed password_recipe.length")
+		}
+		if err := model.ValidateRecipe(parsed); err != nil {
+			return nil, fmt.Errorf("invalid password_recipe: %w", err)
+		}
+	}
+
 	return parsed, nil
 }
 
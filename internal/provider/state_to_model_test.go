@@ -2,12 +2,15 @@ package provider
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 	"reflect"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/model"
+	opssh "github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/ssh"
 )
 
 func TestToModelLoginFields(t *testing.T) {
@@ -271,6 +274,146 @@ func TestToModelDatabaseFields(t *testing.T) {
 	}
 }
 
+func TestToModelAPICredentialFields(t *testing.T) {
+	state := OnePasswordItemResourceModel{
+		Username:   types.StringValue("apiuser"),
+		Credential: types.StringValue("secret-token"),
+		Type:       types.StringValue("bearer"),
+		Filename:   types.StringValue("token.txt"),
+		ValidFrom:  types.StringValue("2025-01-01"),
+		Expires:    types.StringValue("2026-01-01"),
+		Hostname:   types.StringValue("api.example.com"),
+		NoteValue:  types.StringValue("api notes"),
+	}
+
+	got := toModelAPICredentialFields(state)
+
+	want := []model.ItemField{
+		{ID: "username", Label: "username", Type: model.FieldTypeString, Value: "apiuser"},
+		{ID: "credential", Label: "credential", Type: model.FieldTypeConcealed, Value: "secret-token"},
+		{ID: "type", Label: "type", Type: model.FieldTypeMenu, Value: "bearer"},
+		{ID: "filename", Label: "filename", Type: model.FieldTypeString, Value: "token.txt"},
+		{ID: "validFrom", Label: "valid from", Type: model.FieldTypeDate, Value: "2025-01-01"},
+		{ID: "expires", Label: "expires", Type: model.FieldTypeDate, Value: "2026-01-01"},
+		{ID: "hostname", Label: "hostname", Type: model.FieldTypeString, Value: "api.example.com"},
+		{ID: "notesPlain", Label: "notesPlain", Type: model.FieldTypeString, Purpose: model.FieldPurposeNotes, Value: "api notes"},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("toModelAPICredentialFields() = %+v, want %+v", got, want)
+	}
+}
+
+func TestToModelCategoryFields(t *testing.T) {
+	categoryFields, diags := types.MapValueFrom(context.Background(), types.StringType, map[string]string{
+		"expiry":     "202712",
+		"ccnum":      "4111111111111111",
+		"cardholder": "Wendy Appleseed",
+	})
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	state := OnePasswordItemResourceModel{
+		CategoryFields: categoryFields,
+		NoteValue:      types.StringValue("card notes"),
+	}
+
+	got, diags := toModelCategoryFields(context.Background(), state, model.CreditCard)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	want := []model.ItemField{
+		{ID: "cardholder", Label: "cardholder name", Type: model.FieldTypeString, Value: "Wendy Appleseed"},
+		{ID: "ccnum", Label: "number", Type: model.FieldTypeConcealed, Value: "4111111111111111"},
+		{ID: "expiry", Label: "expiry date", Type: model.FieldTypeMonthYear, Value: "202712"},
+		{ID: "notesPlain", Label: "notesPlain", Type: model.FieldTypeString, Purpose: model.FieldPurposeNotes, Value: "card notes"},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("toModelCategoryFields() = %+v, want %+v", got, want)
+	}
+}
+
+func TestToModelDocumentFile(t *testing.T) {
+	sourcePath := filepath.Join(t.TempDir(), "kubeconfig")
+	if err := os.WriteFile(sourcePath, []byte("from source"), 0o600); err != nil {
+		t.Fatalf("Failed to write source file: %v", err)
+	}
+
+	tests := map[string]struct {
+		state       OnePasswordItemResourceModel
+		wantContent []byte
+		wantErr     bool
+	}{
+		"should use content": {
+			state: OnePasswordItemResourceModel{
+				Filename: types.StringValue("ca.pem"),
+				Content:  types.StringValue("plain text"),
+			},
+			wantContent: []byte("plain text"),
+		},
+		"should decode content_base64": {
+			state: OnePasswordItemResourceModel{
+				Filename:      types.StringValue("ca.pem"),
+				ContentBase64: types.StringValue("3q2+7w=="),
+			},
+			wantContent: []byte{0xDE, 0xAD, 0xBE, 0xEF},
+		},
+		"should read source": {
+			state: OnePasswordItemResourceModel{
+				Filename: types.StringValue("ca.pem"),
+				Source:   types.StringValue(sourcePath),
+			},
+			wantContent: []byte("from source"),
+		},
+		"should fail on invalid base64": {
+			state: OnePasswordItemResourceModel{
+				Filename:      types.StringValue("ca.pem"),
+				ContentBase64: types.StringValue("not base64!"),
+			},
+			wantErr: true,
+		},
+		"should fail on missing source": {
+			state: OnePasswordItemResourceModel{
+				Filename: types.StringValue("ca.pem"),
+				Source:   types.StringValue(filepath.Join(t.TempDir(), "missing")),
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			file, diags := toModelDocumentFile(test.state)
+			if test.wantErr {
+				if !diags.HasError() {
+					t.Fatal("Expected error, got none")
+				}
+				return
+			}
+			if diags.HasError() {
+				t.Fatalf("Unexpected error: %v", diags)
+			}
+
+			if file.Name != "ca.pem" {
+				t.Errorf("Name: got %q, want %q", file.Name, "ca.pem")
+			}
+			content, err := file.Content()
+			if err != nil {
+				t.Fatalf("Content not loaded: %v", err)
+			}
+			if !reflect.DeepEqual(content, test.wantContent) {
+				t.Errorf("Content: got %v, want %v", content, test.wantContent)
+			}
+			if file.Size != len(test.wantContent) {
+				t.Errorf("Size: got %d, want %d", file.Size, len(test.wantContent))
+			}
+		})
+	}
+}
+
 func TestToModelSecureNoteFields(t *testing.T) {
 	tests := map[string]struct {
 		state    OnePasswordItemResourceModel
@@ -762,3 +905,207 @@ func TestToModelSectionFieldMap(t *testing.T) {
 		})
 	}
 }
+
+func TestParseGeneratorRecipeFromModel(t *testing.T) {
+	tests := map[string]struct {
+		recipe   *PasswordRecipeModel
+		expected *model.GeneratorRecipe
+		wantErr  bool
+	}{
+		"nil recipe": {
+			recipe:   nil,
+			expected: nil,
+		},
+		"random recipe without type": {
+			recipe: &PasswordRecipeModel{
+				Length:  types.Int64Value(20),
+				Digits:  types.BoolValue(true),
+				Symbols: types.BoolValue(false),
+			},
+			expected: &model.GeneratorRecipe{
+				Type:          model.RecipeTypeRandom,
+				Length:        20,
+				CharacterSets: []model.CharacterSet{model.CharacterSetDigits},
+				WordCount:     4,
+				Separator:     model.SeparatorHyphens,
+				WordList:      model.WordListFullWords,
+			},
+		},
+		"memorable recipe": {
+			recipe: &PasswordRecipeModel{
+				Type:       types.StringValue("memorable"),
+				Length:     types.Int64Value(32),
+				Digits:     types.BoolValue(false),
+				Symbols:    types.BoolValue(false),
+				WordCount:  types.Int64Value(6),
+				Separator:  types.StringValue("digits_and_symbols"),
+				Capitalize: types.BoolValue(true),
+				WordList:   types.StringValue("syllables"),
+			},
+			expected: &model.GeneratorRecipe{
+				Type:          model.RecipeTypeMemorable,
+				Length:        32,
+				CharacterSets: []model.CharacterSet{},
+				WordCount:     6,
+				Separator:     model.SeparatorDigitsAndSymbols,
+				Capitalize:    true,
+				WordList:      model.WordListSyllables,
+			},
+		},
+		"PIN recipe": {
+			recipe: &PasswordRecipeModel{
+				Type:   types.StringValue("pin"),
+				Length: types.Int64Value(6),
+			},
+			expected: &model.GeneratorRecipe{
+				Type:          model.RecipeTypePin,
+				Length:        6,
+				CharacterSets: []model.CharacterSet{},
+				WordCount:     4,
+				Separator:     model.SeparatorHyphens,
+				WordList:      model.WordListFullWords,
+			},
+		},
+		"PIN recipe too long": {
+			recipe: &PasswordRecipeModel{
+				Type:   types.StringValue("pin"),
+				Length: types.Int64Value(32),
+			},
+			wantErr: true,
+		},
+		"random recipe with character options": {
+			recipe: &PasswordRecipeModel{
+				Length:            types.Int64Value(20),
+				Digits:            types.BoolValue(true),
+				Symbols:           types.BoolValue(true),
+				Letters:           types.BoolValue(false),
+				ExcludeCharacters: types.StringValue("*@"),
+				MinDigits:         types.Int64Value(3),
+				MinSymbols:        types.Int64Value(2),
+			},
+			expected: &model.GeneratorRecipe{
+				Type:              model.RecipeTypeRandom,
+				Length:            20,
+				CharacterSets:     []model.CharacterSet{model.CharacterSetDigits, model.CharacterSetSymbols},
+				ExcludeLetters:    true,
+				ExcludeCharacters: "*@",
+				MinDigits:         3,
+				MinSymbols:        2,
+				WordCount:         4,
+				Separator:         model.SeparatorHyphens,
+				WordList:          model.WordListFullWords,
+			},
+		},
+		"random recipe without any character set": {
+			recipe: &PasswordRecipeModel{
+				Length:  types.Int64Value(20),
+				Digits:  types.BoolValue(false),
+				Symbols: types.BoolValue(false),
+				Letters: types.BoolValue(false),
+			},
+			wantErr: true,
+		},
+		"min_digits without digits": {
+			recipe: &PasswordRecipeModel{
+				Length:    types.Int64Value(20),
+				Digits:    types.BoolValue(false),
+				MinDigits: types.Int64Value(2),
+			},
+			wantErr: true,
+		},
+		"minimum counts longer than length": {
+			recipe: &PasswordRecipeModel{
+				Length:     types.Int64Value(8),
+				Digits:     types.BoolValue(true),
+				Symbols:    types.BoolValue(true),
+				MinDigits:  types.Int64Value(5),
+				MinSymbols: types.Int64Value(5),
+			},
+			wantErr: true,
+		},
+		"all digits excluded": {
+			recipe: &PasswordRecipeModel{
+				Length:            types.Int64Value(20),
+				Digits:            types.BoolValue(true),
+				ExcludeCharacters: types.StringValue("0123456789"),
+			},
+			wantErr: true,
+		},
+		"length below the number of required sets": {
+			recipe: &PasswordRecipeModel{
+				Length:  types.Int64Value(2),
+				Digits:  types.BoolValue(true),
+				Symbols: types.BoolValue(true),
+			},
+			wantErr: true,
+		},
+		"random recipe too long": {
+			recipe: &PasswordRecipeModel{
+				Length: types.Int64Value(65),
+			},
+			wantErr: true,
+		},
+	}
+
+	for description, test := range tests {
+		t.Run(description, func(t *testing.T) {
+			actual, err := parseGeneratorRecipeFromModel(test.recipe)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("Error: got err=%v, wantErr=%v", err, test.wantErr)
+			}
+			if !reflect.DeepEqual(actual, test.expected) {
+				t.Errorf("parseGeneratorRecipeFromModel() = %+v, want %+v", actual, test.expected)
+			}
+		})
+	}
+}
+
+func TestToModelSSHKeyFields(t *testing.T) {
+	privateKey, err := opssh.GeneratePrivateKey(opssh.KeyAlgorithmEd25519, 0)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	openSSHPrivateKey, err := opssh.PrivateKeyToOpenSSH([]byte(privateKey), "test-item")
+	if err != nil {
+		t.Fatalf("Failed to convert key: %v", err)
+	}
+
+	tests := map[string]struct {
+		privateKey types.String
+		want       []model.ItemField
+		wantErr    bool
+	}{
+		"PKCS#8 private key": {
+			privateKey: types.StringValue(privateKey),
+			want: []model.ItemField{
+				{ID: "private_key", Label: "private key", Type: model.FieldTypeSSHKey, Value: privateKey},
+			},
+		},
+		"OpenSSH private key is stored as PKCS#8": {
+			privateKey: types.StringValue(openSSHPrivateKey),
+			want: []model.ItemField{
+				{ID: "private_key", Label: "private key", Type: model.FieldTypeSSHKey, Value: privateKey},
+			},
+		},
+		"unknown private key": {
+			privateKey: types.StringNull(),
+			want:       nil,
+		},
+		"invalid private key": {
+			privateKey: types.StringValue("not a key"),
+			wantErr:    true,
+		},
+	}
+
+	for description, test := range tests {
+		t.Run(description, func(t *testing.T) {
+			fields, err := toModelSSHKeyFields(OnePasswordItemResourceModel{PrivateKeyOpenSSH: test.privateKey})
+			if (err != nil) != test.wantErr {
+				t.Fatalf("Error: got err=%v, wantErr=%v", err, test.wantErr)
+			}
+			if !reflect.DeepEqual(fields, test.want) {
+				t.Errorf("toModelSSHKeyFields() = %v, want %v", fields, test.want)
+			}
+		})
+	}
+}
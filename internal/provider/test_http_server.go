@@ -12,9 +12,18 @@ import (
 	"testing"
 
 	"github.com/1Password/connect-sdk-go/onepassword"
+	"github.com/1Password/terraform-provider-onepassword/v2/connectemulator"
 	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/model"
 )
 
+// setupConnectEmulator starts a stateful 1Password Connect API emulator for tests with several vaults or items.
+func setupConnectEmulator(t *testing.T, config connectemulator.Config) (*connectemulator.Emulator, *httptest.Server) {
+	e := connectemulator.New(config)
+	server := httptest.NewServer(e)
+	t.Cleanup(server.Close)
+	return e, server
+}
+
 // setupTestServer sets up a http server that can be used mock out 1Password Connect API calls
 func setupTestServer(expectedItem *model.Item, expectedVault model.Vault, t *testing.T) *httptest.Server {
 	connectItem, err := expectedItem.FromModelItemToConnect()
@@ -61,6 +70,13 @@ func setupTestServer(expectedItem *model.Item, expectedVault model.Vault, t *tes
 				if err != nil {
 					t.Errorf("error writing body: %s", err)
 				}
+			} else if r.URL.String() == "/v1/vaults" {
+				// Mock returning the list of all vaults
+				w.Header().Set("Content-Type", "application/json")
+				_, err := w.Write([]byte("[" + string(vaultBytes) + "]"))
+				if err != nil {
+					t.Errorf("error writing body: %s", err)
+				}
 			} else if r.URL.String() == fmt.Sprintf("/v1/vaults/%s", expectedItem.VaultID) {
 				// Mock returning a vault specified by uuid
 				w.Header().Set("Content-Type", "application/json")
@@ -59,6 +59,35 @@ func generateApiCredentialItem() *model.Item {
 	return &item
 }
 
+func generateApiCredentialResourceItem() *model.Item {
+	item := generateBaseItem()
+	item.Category = model.APICredential
+	item.Fields = []model.ItemField{
+		{ID: "username", Label: "username", Type: model.FieldTypeString, Value: "test_user"},
+		{ID: "credential", Label: "credential", Type: model.FieldTypeConcealed, Value: "test_credential"},
+		{ID: "type", Label: "type", Type: model.FieldTypeMenu, Value: "bearer"},
+		{ID: "filename", Label: "filename", Type: model.FieldTypeString, Value: "test_filename"},
+		{ID: "validFrom", Label: "valid from", Type: model.FieldTypeDate, Value: "2025-01-01"},
+		{ID: "expires", Label: "expires", Type: model.FieldTypeDate, Value: "2026-01-01"},
+		{ID: "hostname", Label: "hostname", Type: model.FieldTypeString, Value: "api.example.com"},
+	}
+
+	return &item
+}
+
+func generateCreditCardItem() *model.Item {
+	item := generateBaseItem()
+	item.Category = model.CreditCard
+	item.Fields = []model.ItemField{
+		{ID: "cardholder", Label: "cardholder name", Type: model.FieldTypeString, Value: "Wendy Appleseed"},
+		{ID: "ccnum", Label: "number", Type: model.FieldTypeConcealed, Value: "4111111111111111"},
+		{ID: "cvv", Label: "verification number", Type: model.FieldTypeConcealed, Value: "123"},
+		{ID: "expiry", Label: "expiry date", Type: model.FieldTypeMonthYear, Value: "202712"},
+	}
+
+	return &item
+}
+
 func generatePasswordItem() *model.Item {
 	item := generateBaseItem()
 	item.Category = model.Password
@@ -81,6 +110,16 @@ func generateLoginItem() *model.Item {
 	return &item
 }
 
+func generateLoginItemWithWebsites() *model.Item {
+	item := generateLoginItem()
+	item.URLs = []model.ItemURL{
+		{URL: "https://example.com", Label: "website"},
+		{URL: "https://login.example.com", Label: "sign-in", Primary: true},
+	}
+
+	return item
+}
+
 func generateSSHKeyItem() *model.Item {
 	item := generateBaseItem()
 	item.Category = model.SSHKey
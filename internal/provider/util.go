@@ -1,7 +1,10 @@
 package provider
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
@@ -17,6 +20,31 @@ func itemTerraformID(item *model.Item) string {
 	return fmt.Sprintf("vaults/%s/items/%s", item.VaultID, item.ID)
 }
 
+// contentHash returns the hex encoded SHA256 hash of the content
+func contentHash(content []byte) string {
+	hash := sha256.Sum256(content)
+	return hex.EncodeToString(hash[:])
+}
+
+// documentContentHash returns the hash of a document file loaded by toModelDocumentFile
+func documentContentHash(file *model.ItemFile) basetypes.StringValue {
+	content, err := file.Content()
+	if err != nil {
+		return types.StringNull()
+	}
+	return types.StringValue(contentHash(content))
+}
+
+// documentFile returns the document file of a document item, the only file outside a section
+func documentFile(item *model.Item) *model.ItemFile {
+	for i := range item.Files {
+		if item.Files[i].SectionID == "" {
+			return &item.Files[i]
+		}
+	}
+	return nil
+}
+
 func setStringValue(value string) basetypes.StringValue {
 	if value == "" {
 		return types.StringNull()
@@ -33,3 +61,11 @@ func setStringValuePreservingEmpty(value string, originalValue basetypes.StringV
 	// Original behavior is to convert empty to null
 	return setStringValue(value)
 }
+
+// setTimeValue formats a timestamp in RFC 3339 format, converting the zero time to null
+func setTimeValue(value time.Time) basetypes.StringValue {
+	if value.IsZero() {
+		return types.StringNull()
+	}
+	return types.StringValue(value.UTC().Format(time.RFC3339))
+}
@@ -2,9 +2,14 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"reflect"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 func ValueModifier() planmodifier.String {
@@ -104,3 +109,196 @@ func (m passwordValueModifierForMapField) PlanModifyString(ctx context.Context,
 
 	resp.PlanValue = req.StateValue
 }
+
+// SSHKeyValueModifier keeps the values derived from an SSH key unchanged unless a new key is imported.
+// Changing the key algorithm or size replaces the resource, so the values are only recomputed on create.
+func SSHKeyValueModifier() planmodifier.String {
+	return sshKeyValueModifier{}
+}
+
+type sshKeyValueModifier struct{}
+
+func (m sshKeyValueModifier) Description(_ context.Context) string {
+	return "Once set, the value of this attribute in state will not change unless the private key is changed."
+}
+
+func (m sshKeyValueModifier) MarkdownDescription(_ context.Context) string {
+	return "Once set, the value of this attribute in state will not change unless the private key is changed."
+}
+
+func (m sshKeyValueModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	// Do nothing on create or destroy.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	// Do nothing if there is a known planned value.
+	if !req.PlanValue.IsUnknown() {
+		return
+	}
+
+	// Check if a new private key is imported. If so, then the value will be recomputed.
+	var stateVersion, planVersion types.Int64
+
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("private_key_wo_version"), &stateVersion)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("private_key_wo_version"), &planVersion)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !stateVersion.Equal(planVersion) {
+		return
+	}
+
+	resp.PlanValue = req.StateValue
+}
+
+// contentAttributes names the attributes a file content can be configured with, relative to the modified attribute.
+type contentAttributes struct {
+	content       string
+	contentBase64 string
+	source        string
+}
+
+var (
+	documentContentAttributes    = contentAttributes{content: "content", contentBase64: "content_base64", source: "source"}
+	sectionFileContentAttributes = contentAttributes{content: "content_wo", contentBase64: "content_base64_wo", source: "source"}
+)
+
+// configuredContent returns the file content configured next to the attribute at attributePath.
+// known is false if the content is not known yet; content is nil if no content is configured.
+func configuredContent(ctx context.Context, config tfsdk.Config, attributePath path.Path, attributes contentAttributes) (content []byte, known bool, diagnostics diag.Diagnostics) {
+	var contentValue, contentBase64Value, sourceValue types.String
+
+	parentPath := attributePath.ParentPath()
+	diagnostics.Append(config.GetAttribute(ctx, parentPath.AtName(attributes.content), &contentValue)...)
+	diagnostics.Append(config.GetAttribute(ctx, parentPath.AtName(attributes.contentBase64), &contentBase64Value)...)
+	diagnostics.Append(config.GetAttribute(ctx, parentPath.AtName(attributes.source), &sourceValue)...)
+	if diagnostics.HasError() {
+		return nil, false, diagnostics
+	}
+
+	if contentValue.IsUnknown() || contentBase64Value.IsUnknown() || sourceValue.IsUnknown() {
+		return nil, false, diagnostics
+	}
+
+	content, err := documentContent(contentValue, contentBase64Value, sourceValue)
+	if err != nil {
+		diagnostics.AddAttributeError(attributePath, "Error reading file content", fmt.Sprintf("Failed to read file content, got error: %s", err))
+		return nil, false, diagnostics
+	}
+
+	return content, true, diagnostics
+}
+
+// ContentHashModifier plans the hash of the file content set in the configuration,
+// so that changes to the file at `source` are detected even though the path is unchanged.
+func ContentHashModifier(attributes contentAttributes) planmodifier.String {
+	return contentHashModifier{attributes: attributes}
+}
+
+type contentHashModifier struct {
+	attributes contentAttributes
+}
+
+func (m contentHashModifier) Description(_ context.Context) string {
+	return "The value of this attribute is the hash of the configured file content."
+}
+
+func (m contentHashModifier) MarkdownDescription(_ context.Context) string {
+	return "The value of this attribute is the hash of the configured file content."
+}
+
+func (m contentHashModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	// Do nothing on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	content, known, diagnostics := configuredContent(ctx, req.Config, req.Path, m.attributes)
+	resp.Diagnostics.Append(diagnostics...)
+	// The hash is computed on apply if the content is not known yet.
+	if resp.Diagnostics.HasError() || !known {
+		return
+	}
+
+	if content == nil {
+		resp.PlanValue = types.StringNull()
+		return
+	}
+
+	resp.PlanValue = types.StringValue(contentHash(content))
+}
+
+// ContentSizeModifier plans the size of the file content set in the configuration.
+func ContentSizeModifier(attributes contentAttributes) planmodifier.Int64 {
+	return contentSizeModifier{attributes: attributes}
+}
+
+type contentSizeModifier struct {
+	attributes contentAttributes
+}
+
+func (m contentSizeModifier) Description(_ context.Context) string {
+	return "The value of this attribute is the size of the configured file content."
+}
+
+func (m contentSizeModifier) MarkdownDescription(_ context.Context) string {
+	return "The value of this attribute is the size of the configured file content."
+}
+
+func (m contentSizeModifier) PlanModifyInt64(ctx context.Context, req planmodifier.Int64Request, resp *planmodifier.Int64Response) {
+	// Do nothing on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	content, known, diagnostics := configuredContent(ctx, req.Config, req.Path, m.attributes)
+	resp.Diagnostics.Append(diagnostics...)
+	if resp.Diagnostics.HasError() || !known || content == nil {
+		return
+	}
+
+	resp.PlanValue = types.Int64Value(int64(len(content)))
+}
+
+// FileIDModifier keeps the ID of an uploaded file unchanged unless its configured content changed,
+// in which case the file is uploaded again and gets a new ID.
+func FileIDModifier(attributes contentAttributes) planmodifier.String {
+	return fileIDModifier{attributes: attributes}
+}
+
+type fileIDModifier struct {
+	attributes contentAttributes
+}
+
+func (m fileIDModifier) Description(_ context.Context) string {
+	return "Once set, the value of this attribute in state will not change unless the file content is changed."
+}
+
+func (m fileIDModifier) MarkdownDescription(_ context.Context) string {
+	return "Once set, the value of this attribute in state will not change unless the file content is changed."
+}
+
+func (m fileIDModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	// Do nothing if there is no state value or a known planned value.
+	if req.StateValue.IsNull() || !req.PlanValue.IsUnknown() {
+		return
+	}
+
+	var stateHash types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, req.Path.ParentPath().AtName("content_hash"), &stateHash)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	content, known, diagnostics := configuredContent(ctx, req.Config, req.Path, m.attributes)
+	resp.Diagnostics.Append(diagnostics...)
+	if resp.Diagnostics.HasError() || !known || content == nil {
+		return
+	}
+
+	if stateHash.ValueString() == contentHash(content) {
+		resp.PlanValue = req.StateValue
+	}
+}
@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/model"
+)
+
+// OnePasswordItemWebsiteModel describes a website of an item.
+type OnePasswordItemWebsiteModel struct {
+	URL              types.String `tfsdk:"url"`
+	Label            types.String `tfsdk:"label"`
+	Primary          types.Bool   `tfsdk:"primary"`
+	AutofillBehavior types.String `tfsdk:"autofill_behavior"`
+}
+
+// toModelURLs returns the websites of the item, or the primary `url` when no website is configured.
+// The first website is primary unless another one is marked as primary.
+func toModelURLs(state OnePasswordItemResourceModel) []model.ItemURL {
+	if len(state.Website) == 0 {
+		return []model.ItemURL{
+			{
+				Primary: true,
+				URL:     state.URL.ValueString(),
+			},
+		}
+	}
+
+	hasPrimary := false
+	for _, w := range state.Website {
+		if w.Primary.ValueBool() {
+			hasPrimary = true
+			break
+		}
+	}
+
+	urls := make([]model.ItemURL, 0, len(state.Website))
+	for i, w := range state.Website {
+		urls = append(urls, model.ItemURL{
+			URL:              w.URL.ValueString(),
+			Label:            w.Label.ValueString(),
+			Primary:          w.Primary.ValueBool() || (!hasPrimary && i == 0),
+			AutofillBehavior: model.AutofillBehavior(strings.ToUpper(w.AutofillBehavior.ValueString())),
+		})
+	}
+
+	return urls
+}
+
+// toStateWebsites updates the websites in state with the websites of the item, keeping the order of the state.
+// Websites are matched by URL, so that reordering by 1Password doesn't cause a diff.
+func toStateWebsites(modelURLs []model.ItemURL, stateWebsites []OnePasswordItemWebsiteModel) []OnePasswordItemWebsiteModel {
+	websites := make([]OnePasswordItemWebsiteModel, 0, len(modelURLs))
+	matched := make([]bool, len(modelURLs))
+
+	for _, stateWebsite := range stateWebsites {
+		for i, u := range modelURLs {
+			if matched[i] || u.URL != stateWebsite.URL.ValueString() {
+				continue
+			}
+			matched[i] = true
+			websites = append(websites, toStateWebsite(u, stateWebsite))
+			break
+		}
+	}
+
+	for i, u := range modelURLs {
+		if !matched[i] && u.URL != "" {
+			websites = append(websites, toStateWebsite(u, OnePasswordItemWebsiteModel{}))
+		}
+	}
+
+	return websites
+}
+
+// toStateWebsite converts a website of the item, keeping the autofill behavior in state when 1Password doesn't report it.
+func toStateWebsite(u model.ItemURL, stateWebsite OnePasswordItemWebsiteModel) OnePasswordItemWebsiteModel {
+	autofillBehavior := stateWebsite.AutofillBehavior
+	if u.AutofillBehavior != "" || autofillBehavior.IsNull() || autofillBehavior.IsUnknown() {
+		autofillBehavior = toStateAutofillBehavior(u.AutofillBehavior)
+	}
+
+	return OnePasswordItemWebsiteModel{
+		URL:              types.StringValue(u.URL),
+		Label:            setStringValuePreservingEmpty(u.Label, stateWebsite.Label),
+		Primary:          types.BoolValue(u.Primary),
+		AutofillBehavior: autofillBehavior,
+	}
+}
+
+// toDataSourceWebsites converts the websites of the item for the data source and the ephemeral resource.
+func toDataSourceWebsites(modelURLs []model.ItemURL) []OnePasswordItemWebsiteModel {
+	websites := make([]OnePasswordItemWebsiteModel, 0, len(modelURLs))
+	for _, u := range modelURLs {
+		if u.URL == "" {
+			continue
+		}
+		websites = append(websites, OnePasswordItemWebsiteModel{
+			URL:              types.StringValue(u.URL),
+			Label:            types.StringValue(u.Label),
+			Primary:          types.BoolValue(u.Primary),
+			AutofillBehavior: toStateAutofillBehavior(u.AutofillBehavior),
+		})
+	}
+	return websites
+}
+
+// toStateAutofillBehavior converts the autofill behavior, which defaults to anywhere on the website.
+func toStateAutofillBehavior(autofillBehavior model.AutofillBehavior) types.String {
+	if autofillBehavior == "" {
+		autofillBehavior = model.AutofillBehaviorAnywhereOnWebsite
+	}
+	return types.StringValue(strings.ToLower(string(autofillBehavior)))
+}
@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/model"
+)
+
+func TestToModelURLs(t *testing.T) {
+	tests := map[string]struct {
+		state    OnePasswordItemResourceModel
+		expected []model.ItemURL
+	}{
+		"should use url without websites": {
+			state: OnePasswordItemResourceModel{
+				URL: types.StringValue("https://example.com"),
+			},
+			expected: []model.ItemURL{
+				{URL: "https://example.com", Primary: true},
+			},
+		},
+		"should make first website primary by default": {
+			state: OnePasswordItemResourceModel{
+				Website: []OnePasswordItemWebsiteModel{
+					{URL: types.StringValue("https://example.com"), Label: types.StringValue("website"), AutofillBehavior: types.StringValue("anywhere_on_website")},
+					{URL: types.StringValue("https://example.org"), Label: types.StringValue("mirror"), AutofillBehavior: types.StringValue("never")},
+				},
+			},
+			expected: []model.ItemURL{
+				{URL: "https://example.com", Label: "website", Primary: true, AutofillBehavior: model.AutofillBehaviorAnywhereOnWebsite},
+				{URL: "https://example.org", Label: "mirror", AutofillBehavior: model.AutofillBehaviorNever},
+			},
+		},
+		"should use website marked as primary": {
+			state: OnePasswordItemResourceModel{
+				Website: []OnePasswordItemWebsiteModel{
+					{URL: types.StringValue("https://example.com"), Label: types.StringValue("website"), Primary: types.BoolUnknown()},
+					{URL: types.StringValue("https://login.example.com"), Label: types.StringValue("sign-in"), Primary: types.BoolValue(true), AutofillBehavior: types.StringValue("exact_domain")},
+				},
+			},
+			expected: []model.ItemURL{
+				{URL: "https://example.com", Label: "website"},
+				{URL: "https://login.example.com", Label: "sign-in", Primary: true, AutofillBehavior: model.AutofillBehaviorExactDomain},
+			},
+		},
+	}
+
+	for description, test := range tests {
+		t.Run(description, func(t *testing.T) {
+			actual := toModelURLs(test.state)
+			if !reflect.DeepEqual(actual, test.expected) {
+				t.Errorf("Expected %+v, got %+v", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestToStateWebsites(t *testing.T) {
+	tests := map[string]struct {
+		modelURLs     []model.ItemURL
+		stateWebsites []OnePasswordItemWebsiteModel
+		expected      []OnePasswordItemWebsiteModel
+	}{
+		"should keep order of state when primary website is moved first": {
+			modelURLs: []model.ItemURL{
+				{URL: "https://login.example.com", Label: "sign-in", Primary: true, AutofillBehavior: model.AutofillBehaviorExactDomain},
+				{URL: "https://example.com", Label: "website", AutofillBehavior: model.AutofillBehaviorAnywhereOnWebsite},
+			},
+			stateWebsites: []OnePasswordItemWebsiteModel{
+				{URL: types.StringValue("https://example.com"), Label: types.StringValue("website"), Primary: types.BoolUnknown(), AutofillBehavior: types.StringValue("anywhere_on_website")},
+				{URL: types.StringValue("https://login.example.com"), Label: types.StringValue("sign-in"), Primary: types.BoolValue(true), AutofillBehavior: types.StringValue("exact_domain")},
+			},
+			expected: []OnePasswordItemWebsiteModel{
+				{URL: types.StringValue("https://example.com"), Label: types.StringValue("website"), Primary: types.BoolValue(false), AutofillBehavior: types.StringValue("anywhere_on_website")},
+				{URL: types.StringValue("https://login.example.com"), Label: types.StringValue("sign-in"), Primary: types.BoolValue(true), AutofillBehavior: types.StringValue("exact_domain")},
+			},
+		},
+		"should keep autofill behavior when it is not reported": {
+			modelURLs: []model.ItemURL{
+				{URL: "https://example.com", Label: "website", Primary: true},
+			},
+			stateWebsites: []OnePasswordItemWebsiteModel{
+				{URL: types.StringValue("https://example.com"), Label: types.StringValue("website"), Primary: types.BoolValue(true), AutofillBehavior: types.StringValue("never")},
+			},
+			expected: []OnePasswordItemWebsiteModel{
+				{URL: types.StringValue("https://example.com"), Label: types.StringValue("website"), Primary: types.BoolValue(true), AutofillBehavior: types.StringValue("never")},
+			},
+		},
+		"should remove deleted websites and add new ones": {
+			modelURLs: []model.ItemURL{
+				{URL: "https://example.com", Label: "website", Primary: true},
+				{URL: "https://new.example.com", Label: "added"},
+			},
+			stateWebsites: []OnePasswordItemWebsiteModel{
+				{URL: types.StringValue("https://deleted.example.com"), Label: types.StringValue("website"), Primary: types.BoolValue(false), AutofillBehavior: types.StringValue("never")},
+				{URL: types.StringValue("https://example.com"), Label: types.StringValue("website"), Primary: types.BoolValue(true), AutofillBehavior: types.StringValue("anywhere_on_website")},
+			},
+			expected: []OnePasswordItemWebsiteModel{
+				{URL: types.StringValue("https://example.com"), Label: types.StringValue("website"), Primary: types.BoolValue(true), AutofillBehavior: types.StringValue("anywhere_on_website")},
+				{URL: types.StringValue("https://new.example.com"), Label: types.StringValue("added"), Primary: types.BoolValue(false), AutofillBehavior: types.StringValue("anywhere_on_website")},
+			},
+		},
+	}
+
+	for description, test := range tests {
+		t.Run(description, func(t *testing.T) {
+			actual := toStateWebsites(test.modelURLs, test.stateWebsites)
+			if !reflect.DeepEqual(actual, test.expected) {
+				t.Errorf("Expected %+v, got %+v", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestToDataSourceWebsites(t *testing.T) {
+	modelURLs := []model.ItemURL{
+		{URL: "https://example.com", Label: "website", Primary: true},
+		{URL: ""},
+		{URL: "https://example.org", Label: "mirror", AutofillBehavior: model.AutofillBehaviorNever},
+	}
+
+	expected := []OnePasswordItemWebsiteModel{
+		{URL: types.StringValue("https://example.com"), Label: types.StringValue("website"), Primary: types.BoolValue(true), AutofillBehavior: types.StringValue("anywhere_on_website")},
+		{URL: types.StringValue("https://example.org"), Label: types.StringValue("mirror"), Primary: types.BoolValue(false), AutofillBehavior: types.StringValue("never")},
+	}
+
+	actual := toDataSourceWebsites(modelURLs)
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, actual)
+	}
+}
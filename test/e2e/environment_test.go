@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 
 	tfconfig "github.com/1Password/terraform-provider-onepassword/v2/test/e2e/terraform/config"
 )
@@ -49,3 +50,38 @@ func TestAccEnvironmentDataSource(t *testing.T) {
 		}},
 	})
 }
+
+func TestAccEnvironmentEphemeral(t *testing.T) {
+	t.Parallel()
+
+	environmentID := os.Getenv("OP_TEST_ENVIRONMENT_ID")
+	if environmentID == "" {
+		t.Skip("OP_TEST_ENVIRONMENT_ID must be set for this test (1Password Environment ID from Developer > View Environments)")
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{{
+			Config: tfconfig.CreateConfigBuilder()(
+				tfconfig.ProviderConfig(),
+				func() string {
+					return fmt.Sprintf(`ephemeral "onepassword_environment" "test_environment" {
+  environment_id = %q
+}
+`, environmentID)
+				},
+			),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				// Verify ephemeral resource is NOT in state
+				resource.TestCheckFunc(func(s *terraform.State) error {
+					ephemeralResource := s.RootModule().Resources["ephemeral.onepassword_environment.test_environment"]
+					if ephemeralResource != nil {
+						return fmt.Errorf("ephemeral resource should not exist in state, but found: %+v", ephemeralResource)
+					}
+
+					return nil
+				}),
+			),
+		}},
+	})
+}
@@ -592,3 +592,33 @@ func TestAccItemDataSource_VaultName(t *testing.T) {
 		})
 	}
 }
+
+func TestAccItemsDataSource(t *testing.T) {
+	t.Parallel()
+
+	item := testItems[model.Database]
+	dataSourceBuilder := tfconfig.CreateConfigBuilder()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{{
+			Config: dataSourceBuilder(
+				tfconfig.ProviderConfig(),
+				func() string {
+					return fmt.Sprintf(`data "onepassword_items" "test_items" {
+  vault       = %q
+  category    = "database"
+  title_regex = "^%s$"
+}
+`, testVaultID, regexp.QuoteMeta(item.Title))
+				},
+			),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr("data.onepassword_items.test_items", "items.#", "1"),
+				resource.TestCheckResourceAttr("data.onepassword_items.test_items", "items.0.uuid", item.UUID),
+				resource.TestCheckResourceAttr("data.onepassword_items.test_items", "items.0.title", item.Title),
+				resource.TestCheckResourceAttr("data.onepassword_items.test_items", "items.0.category", "database"),
+			),
+		}},
+	})
+}
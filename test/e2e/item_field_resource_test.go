@@ -0,0 +1,146 @@
+package integration
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/model"
+	tfconfig "github.com/1Password/terraform-provider-onepassword/v2/test/e2e/terraform/config"
+	"github.com/1Password/terraform-provider-onepassword/v2/test/e2e/utils/client"
+	"github.com/1Password/terraform-provider-onepassword/v2/test/e2e/utils/vault"
+)
+
+func TestAccItemFieldResource(t *testing.T) {
+	t.Parallel()
+
+	testVaultID := vault.GetTestVaultID(t)
+	uniqueID := uuid.New().String()
+	title := addUniqueIDToTitle("Test Item Field", uniqueID)
+
+	// The item is owned by a human, only one of its fields is managed by Terraform.
+	// It is created before the first step and looked up by title, as its ID is only known then.
+	var itemID string
+	createItem := func() {
+		ctx := context.Background()
+		testClient, err := client.CreateTestClient(ctx)
+		if err != nil {
+			t.Fatalf("failed to create client: %v", err)
+		}
+
+		item, err := testClient.CreateItem(ctx, &model.Item{
+			Title:    title,
+			VaultID:  testVaultID,
+			Category: model.Login,
+			URLs:     []model.ItemURL{{URL: "https://example.com", Primary: true}},
+			Sections: []model.ItemSection{{ID: "api", Label: "API"}},
+			Fields: []model.ItemField{
+				{ID: "username", Label: "username", Purpose: model.FieldPurposeUsername, Type: model.FieldTypeString, Value: "human@example.com"},
+				{ID: "clientid", Label: "client id", Type: model.FieldTypeString, Value: "client", SectionID: "api", SectionLabel: "API"},
+			},
+		}, testVaultID)
+		if err != nil {
+			t.Fatalf("failed to create item: %v", err)
+		}
+		itemID = item.ID
+		t.Cleanup(func() {
+			if err := testClient.DeleteItem(context.Background(), item, testVaultID); err != nil {
+				t.Logf("Cleanup: failed to delete item %s: %v", item.ID, err)
+			}
+		})
+	}
+
+	itemDataSource := tfconfig.ItemDataSourceConfig(map[string]string{
+		"vault": testVaultID,
+		"title": title,
+	})
+	itemRef := "data.onepassword_item.test_item.uuid"
+
+	createAttrs := map[string]any{
+		"section": "API",
+		"label":   "token",
+		"type":    "CONCEALED",
+		"value":   "initial-token",
+	}
+	updatedAttrs := map[string]any{
+		"section": "API",
+		"label":   "token",
+		"type":    "CONCEALED",
+		"value":   "rotated-token",
+	}
+
+	verifyItem := func(expectedToken string) resource.TestCheckFunc {
+		return func(s *terraform.State) error {
+			ctx := context.Background()
+			testClient, err := client.CreateTestClient(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to create client: %w", err)
+			}
+
+			current, err := testClient.GetItem(ctx, itemID, testVaultID)
+			if err != nil {
+				return fmt.Errorf("failed to get item: %w", err)
+			}
+
+			values := map[string]string{}
+			for _, f := range current.Fields {
+				values[f.SectionLabel+"/"+f.Label] = f.Value
+			}
+			if values["/username"] != "human@example.com" || values["API/client id"] != "client" {
+				return fmt.Errorf("expected fields not managed by Terraform to be kept, got %v", values)
+			}
+			if token, ok := values["API/token"]; expectedToken == "" && ok {
+				return fmt.Errorf("expected token field to be removed, got %q", token)
+			} else if expectedToken != "" && token != expectedToken {
+				return fmt.Errorf("expected token %q, got %q", expectedToken, token)
+			}
+			return nil
+		}
+	}
+
+	verifyID := func(s *terraform.State) error {
+		expected := fmt.Sprintf("vaults/%s/items/%s/sections/API/fields/token", testVaultID, itemID)
+		return resource.TestCheckResourceAttr("onepassword_item_field.test_field", "id", expected)(s)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             verifyItem(""),
+		Steps: []resource.TestStep{
+			{
+				PreConfig: createItem,
+				Config: tfconfig.CreateConfigBuilder()(
+					tfconfig.ProviderConfig(),
+					itemDataSource,
+					tfconfig.ItemFieldResourceConfig(testVaultID, itemRef, createAttrs),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					verifyID,
+					resource.TestCheckResourceAttr("onepassword_item_field.test_field", "value", "initial-token"),
+					resource.TestCheckResourceAttrSet("onepassword_item_field.test_field", "field_id"),
+					verifyItem("initial-token"),
+				),
+			},
+			{
+				Config: tfconfig.CreateConfigBuilder()(
+					tfconfig.ProviderConfig(),
+					itemDataSource,
+					tfconfig.ItemFieldResourceConfig(testVaultID, itemRef, updatedAttrs),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("onepassword_item_field.test_field", "value", "rotated-token"),
+					verifyItem("rotated-token"),
+				),
+			},
+			{
+				ResourceName:      "onepassword_item_field.test_field",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/1Password/terraform-provider-onepassword/v2/test/e2e/utils/client"
 	"github.com/1Password/terraform-provider-onepassword/v2/test/e2e/utils/password"
 	"github.com/1Password/terraform-provider-onepassword/v2/test/e2e/utils/sections"
+	"github.com/1Password/terraform-provider-onepassword/v2/test/e2e/utils/ssh"
 	uuidutil "github.com/1Password/terraform-provider-onepassword/v2/test/e2e/utils/uuid"
 	"github.com/1Password/terraform-provider-onepassword/v2/test/e2e/utils/vault"
 )
@@ -70,6 +71,20 @@ var testItemsToCreate = map[model.ItemCategory]testResourceItem{
 			"tags":       []string{"firstTestTag", "secondTestTag"},
 		},
 	},
+	model.APICredential: {
+		Attrs: map[string]any{
+			"title":      "Test API Credential Create",
+			"category":   "api_credential",
+			"username":   "testUsername",
+			"credential": "testCredential",
+			"type":       "bearer",
+			"hostname":   "api.example.com",
+			"valid_from": "2025-01-01",
+			"expires":    "2026-01-01",
+			"note_value": "Test API credential note",
+			"tags":       []string{"firstTestTag", "secondTestTag"},
+		},
+	},
 }
 
 var testItemsUpdatedAttrs = map[model.ItemCategory]map[string]any{
@@ -106,6 +121,18 @@ var testItemsUpdatedAttrs = map[model.ItemCategory]map[string]any{
 		"note_value": "This is an updated secure note",
 		"tags":       []string{"firstUpdatedTestTag", "secondUpdatedTestTag"},
 	},
+	model.APICredential: {
+		"title":      "Test API Credential Create",
+		"category":   "api_credential",
+		"username":   "updatedUsername",
+		"credential": "updatedCredential",
+		"type":       "basic",
+		"hostname":   "updated-api.example.com",
+		"valid_from": "2025-06-01",
+		"expires":    "2027-01-01",
+		"note_value": "Updated API credential note",
+		"tags":       []string{"firstUpdatedTestTag", "secondUpdatedTestTag"},
+	},
 }
 
 func TestMain(m *testing.M) {
@@ -129,6 +156,7 @@ func TestAccItemResource(t *testing.T) {
 		{category: model.Password, name: "Password"},
 		{category: model.Database, name: "Database"},
 		{category: model.SecureNote, name: "SecureNote"},
+		{category: model.APICredential, name: "APICredential"},
 	}
 
 	testVaultID := vault.GetTestVaultID(t)
@@ -281,14 +309,30 @@ func TestAccItemResourcePasswordGeneration(t *testing.T) {
 	}
 }
 
-// Test that letters is not supported and will error if configured as this field is deprecated
-func TestAccItemResourcePasswordGeneration_InvalidLetters(t *testing.T) {
+// TestAccItemResourcePasswordGeneration_Letters tests that letters can be disabled
+// and that a recipe without any character set is rejected
+func TestAccItemResourcePasswordGeneration_Letters(t *testing.T) {
 	testCases := []struct {
-		name    string
-		letters bool
+		name        string
+		recipe      map[string]any
+		pattern     string
+		expectError *regexp.Regexp
 	}{
-		{name: "LettersTrue", letters: true},
-		{name: "LettersFalse", letters: false},
+		{
+			name:    "DigitsOnly",
+			recipe:  map[string]any{"length": 20, "symbols": false, "digits": true, "letters": false},
+			pattern: "^[0-9]{20}$",
+		},
+		{
+			name:    "ExcludeCharacters",
+			recipe:  map[string]any{"length": 20, "symbols": true, "digits": true, "exclude_characters": "*@0O"},
+			pattern: "^[^*@0O]{20}$",
+		},
+		{
+			name:        "NoCharacterSets",
+			recipe:      map[string]any{"length": 20, "symbols": false, "digits": false, "letters": false},
+			expectError: regexp.MustCompile(`must allow at least one of letters, digits or symbols`),
+		},
 	}
 
 	testVaultID := vault.GetTestVaultID(t)
@@ -300,19 +344,67 @@ func TestAccItemResourcePasswordGeneration_InvalidLetters(t *testing.T) {
 			// Generate unique identifier for this test run to avoid conflicts in parallel execution
 			uniqueID := uuid.New().String()
 
-			recipeMap := map[string]any{
-				"length":  20,
-				"symbols": false,
-				"digits":  false,
-				"letters": tc.letters,
-			}
-
 			attrs := map[string]any{
 				"title":           addUniqueIDToTitle(item.Attrs["title"].(string), uniqueID),
 				"category":        item.Attrs["category"],
-				"password_recipe": recipeMap,
+				"password_recipe": tc.recipe,
+			}
+
+			testStep := resource.TestStep{
+				Config: tfconfig.CreateConfigBuilder()(
+					tfconfig.ProviderConfig(),
+					tfconfig.ItemResourceConfig(testVaultID, attrs),
+				),
+			}
+
+			if tc.expectError != nil {
+				testStep.ExpectError = tc.expectError
+			} else {
+				var itemUUID string
+				testStep.Check = resource.ComposeAggregateTestCheckFunc(
+					uuidutil.CaptureItemUUID(t, "onepassword_item.test_item", &itemUUID),
+					cleanup.RegisterItem(t, &itemUUID, testVaultID),
+					resource.TestMatchResourceAttr("onepassword_item.test_item", "password", regexp.MustCompile(tc.pattern)),
+				)
 			}
 
+			resource.Test(t, resource.TestCase{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps:                    []resource.TestStep{testStep},
+			})
+		})
+	}
+}
+
+// TestAccItemResourceSSHKey tests creating SSH key items with a generated key
+func TestAccItemResourceSSHKey(t *testing.T) {
+	testCases := []struct {
+		name      string
+		algorithm string
+		bits      int
+		keyPrefix string
+	}{
+		{name: "Ed25519", algorithm: "ed25519", keyPrefix: "ssh-ed25519 "},
+		{name: "RSA", algorithm: "rsa", bits: 2048, keyPrefix: "ssh-rsa "},
+	}
+
+	testVaultID := vault.GetTestVaultID(t)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Generate unique identifier for this test run to avoid conflicts in parallel execution
+			uniqueID := uuid.New().String()
+
+			attrs := map[string]any{
+				"title":             addUniqueIDToTitle("Test SSH Key Create", uniqueID),
+				"category":          "ssh_key",
+				"ssh_key_algorithm": tc.algorithm,
+			}
+			if tc.bits != 0 {
+				attrs["ssh_key_rsa_bits"] = tc.bits
+			}
+
+			var itemUUID string
 			resource.Test(t, resource.TestCase{
 				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 				Steps: []resource.TestStep{
@@ -321,7 +413,20 @@ func TestAccItemResourcePasswordGeneration_InvalidLetters(t *testing.T) {
 							tfconfig.ProviderConfig(),
 							tfconfig.ItemResourceConfig(testVaultID, attrs),
 						),
-						ExpectError: regexp.MustCompile(`An argument named "letters" is not expected here`),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							uuidutil.CaptureItemUUID(t, "onepassword_item.test_item", &itemUUID),
+							cleanup.RegisterItem(t, &itemUUID, testVaultID),
+							resource.TestMatchResourceAttr("onepassword_item.test_item", "public_key", regexp.MustCompile("^"+tc.keyPrefix)),
+							resource.TestMatchResourceAttr("onepassword_item.test_item", "fingerprint", regexp.MustCompile("^SHA256:")),
+							func(s *terraform.State) error {
+								item, ok := s.RootModule().Resources["onepassword_item.test_item"]
+								if !ok {
+									return fmt.Errorf("resource not found in state")
+								}
+
+								return ssh.ValidateSSHKeys(item.Primary.Attributes["public_key"], item.Primary.Attributes["private_key_openssh"])
+							},
+						),
 					},
 				},
 			})
@@ -329,6 +434,337 @@ func TestAccItemResourcePasswordGeneration_InvalidLetters(t *testing.T) {
 	}
 }
 
+func TestAccItemResourceDocument(t *testing.T) {
+	if os.Getenv("OP_CONNECT_HOST") != "" {
+		t.Skip("Uploading documents is not supported with 1Password Connect")
+	}
+
+	testVaultID := vault.GetTestVaultID(t)
+	uniqueID := uuid.New().String()
+
+	createAttrs := map[string]any{
+		"title":    addUniqueIDToTitle("Test Document Create", uniqueID),
+		"category": "document",
+		"filename": "config.json",
+		"content":  `{"key": "value"}`,
+	}
+
+	updatedAttrs := maps.Clone(createAttrs)
+	updatedAttrs["content"] = `{"key": "updated"}`
+
+	var itemUUID string
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: tfconfig.CreateConfigBuilder()(
+					tfconfig.ProviderConfig(),
+					tfconfig.ItemResourceConfig(testVaultID, createAttrs),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					uuidutil.CaptureItemUUID(t, "onepassword_item.test_item", &itemUUID),
+					cleanup.RegisterItem(t, &itemUUID, testVaultID),
+					resource.TestCheckResourceAttr("onepassword_item.test_item", "filename", "config.json"),
+					resource.TestCheckResourceAttr("onepassword_item.test_item", "content_hash", "9724c1e20e6e3e4d7f57ed25f9d4efb006e508590d528c90da597f6a775c13e5"),
+				),
+			},
+			{
+				Config: tfconfig.CreateConfigBuilder()(
+					tfconfig.ProviderConfig(),
+					tfconfig.ItemResourceConfig(testVaultID, updatedAttrs),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					uuidutil.VerifyItemUUIDUnchanged(t, "onepassword_item.test_item", &itemUUID),
+					resource.TestCheckResourceAttr("onepassword_item.test_item", "content_hash", "f0eae30edfde13d319a5df465243958091dbd6b2311519b527912a7c8089ebd3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccItemResourceCreditCard(t *testing.T) {
+	testVaultID := vault.GetTestVaultID(t)
+	uniqueID := uuid.New().String()
+
+	createAttrs := map[string]any{
+		"title":    addUniqueIDToTitle("Test Credit Card Create", uniqueID),
+		"category": "credit_card",
+		"category_fields": map[string]any{
+			"cardholder": "Wendy Appleseed",
+			"type":       "visa",
+			"ccnum":      "4111111111111111",
+			"cvv":        "123",
+			"expiry":     "202712",
+			"validFrom":  "202412",
+		},
+	}
+
+	updatedAttrs := maps.Clone(createAttrs)
+	updatedAttrs["category_fields"] = map[string]any{
+		"cardholder": "Wendy Appleseed",
+		"type":       "visa",
+		"ccnum":      "4111111111111111",
+		"cvv":        "456",
+		"expiry":     "202812",
+		"validFrom":  "202412",
+	}
+
+	var itemUUID string
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: tfconfig.CreateConfigBuilder()(
+					tfconfig.ProviderConfig(),
+					tfconfig.ItemResourceConfig(testVaultID, createAttrs),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					uuidutil.CaptureItemUUID(t, "onepassword_item.test_item", &itemUUID),
+					cleanup.RegisterItem(t, &itemUUID, testVaultID),
+					resource.TestCheckResourceAttr("onepassword_item.test_item", "category", "credit_card"),
+					resource.TestCheckResourceAttr("onepassword_item.test_item", "category_fields.cvv", "123"),
+					resource.TestCheckResourceAttr("onepassword_item.test_item", "category_fields.expiry", "202712"),
+					resource.TestCheckResourceAttr("onepassword_item.test_item", "category_fields.validFrom", "202412"),
+				),
+			},
+			{
+				Config: tfconfig.CreateConfigBuilder()(
+					tfconfig.ProviderConfig(),
+					tfconfig.ItemResourceConfig(testVaultID, updatedAttrs),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					uuidutil.VerifyItemUUIDUnchanged(t, "onepassword_item.test_item", &itemUUID),
+					resource.TestCheckResourceAttr("onepassword_item.test_item", "category_fields.cvv", "456"),
+					resource.TestCheckResourceAttr("onepassword_item.test_item", "category_fields.expiry", "202812"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccItemResourceWebsites(t *testing.T) {
+	testVaultID := vault.GetTestVaultID(t)
+	uniqueID := uuid.New().String()
+
+	createAttrs := map[string]any{
+		"title":    addUniqueIDToTitle("Test Websites", uniqueID),
+		"category": "login",
+		"website": []map[string]any{
+			{"url": "https://example.com"},
+			{"url": "https://login.example.com", "label": "sign-in address", "primary": true},
+		},
+	}
+
+	updatedAttrs := maps.Clone(createAttrs)
+	updatedAttrs["website"] = []map[string]any{
+		{"url": "https://example.com"},
+		{"url": "https://login.example.com", "label": "sign-in address", "primary": true},
+		{"url": "https://example.org", "label": "mirror"},
+	}
+
+	var itemUUID string
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: tfconfig.CreateConfigBuilder()(
+					tfconfig.ProviderConfig(),
+					tfconfig.ItemResourceConfig(testVaultID, createAttrs),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					uuidutil.CaptureItemUUID(t, "onepassword_item.test_item", &itemUUID),
+					cleanup.RegisterItem(t, &itemUUID, testVaultID),
+					resource.TestCheckResourceAttr("onepassword_item.test_item", "website.#", "2"),
+					resource.TestCheckResourceAttr("onepassword_item.test_item", "website.0.primary", "false"),
+					resource.TestCheckResourceAttr("onepassword_item.test_item", "website.1.primary", "true"),
+				),
+			},
+			{
+				Config: tfconfig.CreateConfigBuilder()(
+					tfconfig.ProviderConfig(),
+					tfconfig.ItemResourceConfig(testVaultID, updatedAttrs),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					uuidutil.VerifyItemUUIDUnchanged(t, "onepassword_item.test_item", &itemUUID),
+					resource.TestCheckResourceAttr("onepassword_item.test_item", "website.#", "3"),
+					resource.TestCheckResourceAttr("onepassword_item.test_item", "website.2.label", "mirror"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccItemResourceFieldOwnershipManagedOnly(t *testing.T) {
+	testVaultID := vault.GetTestVaultID(t)
+	uniqueID := uuid.New().String()
+
+	createAttrs := map[string]any{
+		"title":           addUniqueIDToTitle("Test Field Ownership", uniqueID),
+		"category":        "login",
+		"username":        "testuser@example.com",
+		"field_ownership": "managed_only",
+		"section": sections.MapSections([]sections.TestSection{
+			{
+				Label: "Managed Section",
+				Fields: []sections.TestField{
+					{Label: "host", Value: "db.example.com", Type: "STRING"},
+				},
+			},
+		}),
+	}
+
+	updatedAttrs := maps.Clone(createAttrs)
+	updatedAttrs["username"] = "updateduser@example.com"
+
+	var itemUUID string
+
+	// Add a section and notes to the item, as they would be added in the 1Password app
+	addFieldsOutsideTerraform := func(s *terraform.State) error {
+		ctx := context.Background()
+		client, err := client.CreateTestClient(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to create client: %w", err)
+		}
+
+		item, err := client.GetItem(ctx, itemUUID, testVaultID)
+		if err != nil {
+			return fmt.Errorf("failed to get item: %w", err)
+		}
+		item.Sections = append(item.Sections, model.ItemSection{ID: "addedinapp", Label: "Added in app"})
+		item.Fields = append(item.Fields,
+			model.ItemField{ID: "recoverycode", Label: "recovery code", Type: model.FieldTypeConcealed, Value: "ABCD-EFGH", SectionID: "addedinapp", SectionLabel: "Added in app"},
+			model.ItemField{ID: "notesPlain", Label: "notesPlain", Type: model.FieldTypeString, Purpose: model.FieldPurposeNotes, Value: "Added in app"},
+		)
+
+		_, err = client.UpdateItem(ctx, item, testVaultID)
+		if err != nil {
+			return fmt.Errorf("failed to update item: %w", err)
+		}
+		return nil
+	}
+
+	// Verify the section and notes added outside of Terraform were kept
+	verifyFieldsKept := func(s *terraform.State) error {
+		ctx := context.Background()
+		client, err := client.CreateTestClient(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to create client: %w", err)
+		}
+
+		item, err := client.GetItem(ctx, itemUUID, testVaultID)
+		if err != nil {
+			return fmt.Errorf("failed to get item: %w", err)
+		}
+
+		var recoveryCode, notes string
+		for _, f := range item.Fields {
+			if f.SectionLabel == "Added in app" && f.Label == "recovery code" {
+				recoveryCode = f.Value
+			}
+			if f.Purpose == model.FieldPurposeNotes {
+				notes = f.Value
+			}
+		}
+		if recoveryCode != "ABCD-EFGH" {
+			return fmt.Errorf("expected field added outside of Terraform to be kept, got %q", recoveryCode)
+		}
+		if notes != "Added in app" {
+			return fmt.Errorf("expected notes added outside of Terraform to be kept, got %q", notes)
+		}
+		return nil
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: tfconfig.CreateConfigBuilder()(
+					tfconfig.ProviderConfig(),
+					tfconfig.ItemResourceConfig(testVaultID, createAttrs),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					uuidutil.CaptureItemUUID(t, "onepassword_item.test_item", &itemUUID),
+					cleanup.RegisterItem(t, &itemUUID, testVaultID),
+					addFieldsOutsideTerraform,
+				),
+			},
+			{
+				Config: tfconfig.CreateConfigBuilder()(
+					tfconfig.ProviderConfig(),
+					tfconfig.ItemResourceConfig(testVaultID, updatedAttrs),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					uuidutil.VerifyItemUUIDUnchanged(t, "onepassword_item.test_item", &itemUUID),
+					resource.TestCheckResourceAttr("onepassword_item.test_item", "username", "updateduser@example.com"),
+					resource.TestCheckResourceAttr("onepassword_item.test_item", "section.#", "1"),
+					resource.TestCheckNoResourceAttr("onepassword_item.test_item", "note_value"),
+					verifyFieldsKept,
+				),
+			},
+		},
+	})
+}
+
+func TestAccItemResourceSectionFiles(t *testing.T) {
+	if os.Getenv("OP_CONNECT_HOST") != "" {
+		t.Skip("Uploading files is not supported with 1Password Connect")
+	}
+
+	testVaultID := vault.GetTestVaultID(t)
+	uniqueID := uuid.New().String()
+
+	sectionWithFile := func(content string) []map[string]any {
+		return []map[string]any{
+			{
+				"label": "TLS",
+				"file": []map[string]any{
+					{"name": "ca.pem", "content_wo": content},
+				},
+			},
+		}
+	}
+
+	createAttrs := map[string]any{
+		"title":    addUniqueIDToTitle("Test Section Files", uniqueID),
+		"category": "secure_note",
+		"section":  sectionWithFile("certificate"),
+	}
+
+	updatedAttrs := maps.Clone(createAttrs)
+	updatedAttrs["section"] = sectionWithFile("rotated certificate")
+
+	var itemUUID string
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: tfconfig.CreateConfigBuilder()(
+					tfconfig.ProviderConfig(),
+					tfconfig.ItemResourceConfig(testVaultID, createAttrs),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					uuidutil.CaptureItemUUID(t, "onepassword_item.test_item", &itemUUID),
+					cleanup.RegisterItem(t, &itemUUID, testVaultID),
+					resource.TestCheckResourceAttr("onepassword_item.test_item", "section.0.file.0.name", "ca.pem"),
+					resource.TestCheckResourceAttr("onepassword_item.test_item", "section.0.file.0.size", "11"),
+					resource.TestCheckResourceAttrSet("onepassword_item.test_item", "section.0.file.0.id"),
+					resource.TestCheckNoResourceAttr("onepassword_item.test_item", "section.0.file.0.content_wo"),
+				),
+			},
+			{
+				Config: tfconfig.CreateConfigBuilder()(
+					tfconfig.ProviderConfig(),
+					tfconfig.ItemResourceConfig(testVaultID, updatedAttrs),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					uuidutil.VerifyItemUUIDUnchanged(t, "onepassword_item.test_item", &itemUUID),
+					resource.TestCheckResourceAttr("onepassword_item.test_item", "section.0.file.0.size", "19"),
+				),
+			},
+		},
+	})
+}
+
 // TestAccItemResourceSectionFieldPasswordGeneration tests the generation of passwords on fields
 func TestAccItemResourceSectionFieldPasswordGeneration(t *testing.T) {
 	testCases := []struct {
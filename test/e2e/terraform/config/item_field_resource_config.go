@@ -0,0 +1,25 @@
+package terraform
+
+import "fmt"
+
+// ItemFieldResourceConfig returns the configuration of a field resource on the item that itemRef,
+// an expression such as a data source attribute, evaluates to the UUID of.
+func ItemFieldResourceConfig(vaultID, itemRef string, params map[string]any) func() string {
+	return func() string {
+		resourceStr := `resource "onepassword_item_field" "test_field" {`
+
+		resourceStr += fmt.Sprintf("\n  vault = %q", vaultID)
+		resourceStr += fmt.Sprintf("\n  item = %s", itemRef)
+
+		for key, value := range params {
+			attr, err := formatTerraformAttribute(key, value, 1)
+			if err != nil {
+				return fmt.Sprintf("ERROR: %v", err)
+			}
+			resourceStr += attr
+		}
+
+		resourceStr += "\n}"
+		return resourceStr
+	}
+}
@@ -27,8 +27,9 @@ func ItemResourceConfig(vaultID string, params map[string]any) func() string {
 
 // mapAttributeKeys defines which attributes should use map syntax (= {}) instead of block syntax
 var mapAttributeKeys = map[string]bool{
-	"section_map": true,
-	"field_map":   true,
+	"section_map":     true,
+	"field_map":       true,
+	"category_fields": true,
 }
 
 func formatTerraformAttribute(key string, value any, indent int) (string, error) {
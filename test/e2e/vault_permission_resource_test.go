@@ -0,0 +1,85 @@
+package integration
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	tfconfig "github.com/1Password/terraform-provider-onepassword/v2/test/e2e/terraform/config"
+)
+
+// vaultPermissionResourceConfig returns Terraform config for a vault and a group permission on it. Private to this test.
+func vaultPermissionResourceConfig(vaultName, groupID string, permissions []string) string {
+	permissionList := ""
+	for _, permission := range permissions {
+		permissionList += fmt.Sprintf("%q, ", permission)
+	}
+
+	return fmt.Sprintf(`resource "onepassword_vault" "test_vault" {
+  name = %q
+}
+
+resource "onepassword_vault_permission" "test_permission" {
+  vault       = onepassword_vault.test_vault.uuid
+  group       = %q
+  permissions = [%s]
+}
+`, vaultName, groupID, permissionList)
+}
+
+func TestAccVaultPermissionResource(t *testing.T) {
+	t.Parallel()
+
+	if os.Getenv("OP_CONNECT_HOST") != "" {
+		t.Skip("Managing vault permissions is not supported with 1Password Connect")
+	}
+
+	groupID := os.Getenv("OP_TEST_GROUP_ID")
+	if groupID == "" {
+		t.Skip("OP_TEST_GROUP_ID must be set to run vault permission tests")
+	}
+
+	vaultName := fmt.Sprintf("tf-e2e-vault-permission-%s", uuid.New().String())
+
+	resourceBuilder := tfconfig.CreateConfigBuilder()
+	updatedResourceBuilder := tfconfig.CreateConfigBuilder()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceBuilder(
+					tfconfig.ProviderConfig(),
+					func() string {
+						return vaultPermissionResourceConfig(vaultName, groupID, []string{"read_items", "reveal_item_password"})
+					},
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("onepassword_vault_permission.test_permission", "group", groupID),
+					resource.TestCheckResourceAttr("onepassword_vault_permission.test_permission", "permissions.#", "2"),
+					resource.TestCheckTypeSetElemAttr("onepassword_vault_permission.test_permission", "permissions.*", "read_items"),
+				),
+			},
+			{
+				Config: updatedResourceBuilder(
+					tfconfig.ProviderConfig(),
+					func() string {
+						return vaultPermissionResourceConfig(vaultName, groupID, []string{"read_items", "reveal_item_password", "update_items"})
+					},
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("onepassword_vault_permission.test_permission", "permissions.#", "3"),
+					resource.TestCheckTypeSetElemAttr("onepassword_vault_permission.test_permission", "permissions.*", "update_items"),
+				),
+			},
+			{
+				ResourceName:      "onepassword_vault_permission.test_permission",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
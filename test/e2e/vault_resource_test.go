@@ -0,0 +1,68 @@
+package integration
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	tfconfig "github.com/1Password/terraform-provider-onepassword/v2/test/e2e/terraform/config"
+)
+
+// vaultResourceConfig returns Terraform config for the onepassword_vault resource. Private to this test.
+func vaultResourceConfig(name, description string) string {
+	return fmt.Sprintf(`resource "onepassword_vault" "test_vault" {
+  name        = %q
+  description = %q
+}
+`, name, description)
+}
+
+func TestAccVaultResource(t *testing.T) {
+	t.Parallel()
+
+	if os.Getenv("OP_CONNECT_HOST") != "" {
+		t.Skip("Managing vaults is not supported with 1Password Connect")
+	}
+
+	name := fmt.Sprintf("tf-e2e-vault-%s", uuid.New().String())
+	updatedName := name + "-updated"
+
+	resourceBuilder := tfconfig.CreateConfigBuilder()
+	updatedResourceBuilder := tfconfig.CreateConfigBuilder()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceBuilder(
+					tfconfig.ProviderConfig(),
+					func() string { return vaultResourceConfig(name, "Created by e2e tests") },
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("onepassword_vault.test_vault", "id", regexp.MustCompile("^vaults/[a-z0-9]{26}$")),
+					resource.TestCheckResourceAttr("onepassword_vault.test_vault", "name", name),
+					resource.TestCheckResourceAttr("onepassword_vault.test_vault", "description", "Created by e2e tests"),
+				),
+			},
+			{
+				Config: updatedResourceBuilder(
+					tfconfig.ProviderConfig(),
+					func() string { return vaultResourceConfig(updatedName, "Updated by e2e tests") },
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("onepassword_vault.test_vault", "name", updatedName),
+					resource.TestCheckResourceAttr("onepassword_vault.test_vault", "description", "Updated by e2e tests"),
+				),
+			},
+			{
+				ResourceName:      "onepassword_vault.test_vault",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
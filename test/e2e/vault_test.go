@@ -83,3 +83,30 @@ func TestAccVaultDataSource(t *testing.T) {
 		})
 	}
 }
+
+func TestAccVaultsDataSource(t *testing.T) {
+	t.Parallel()
+
+	dataSourceBuilder := tfconfig.CreateConfigBuilder()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{{
+			Config: dataSourceBuilder(
+				tfconfig.ProviderConfig(),
+				func() string {
+					return `data "onepassword_vaults" "test_vaults" {
+  name_regex = "^terraform-provider-acceptance-tests$"
+}
+`
+				},
+			),
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("data.onepassword_vaults.test_vaults", "vaults.#", "1"),
+				resource.TestCheckResourceAttr("data.onepassword_vaults.test_vaults", "vaults.0.uuid", "bbucuyq2nn4fozygwttxwizpcy"),
+				resource.TestCheckResourceAttr("data.onepassword_vaults.test_vaults", "vaults.0.name", "terraform-provider-acceptance-tests"),
+				resource.TestCheckResourceAttrSet("data.onepassword_vaults.test_vaults", "vaults.0.item_count"),
+			),
+		}},
+	})
+}